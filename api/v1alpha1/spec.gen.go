@@ -18,27 +18,136 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXXXPaOBT9KxrtPtoYCEm3vFHINmQDyW6T7bSZTEfYF1AiS44kB2iG/74jyYCN1SQz",
-	"23RmZ/sUrI/7pXPPPXnEsUgzwYFrhbuPWMVzSIn92csyRmOiqeDmM5MiA6kp2E1OUjB/E1CxpJk7hMck",
-	"BSSmSM8BkdL1AMOSpBkD3MUky0IF8gFk2GzhAOtVZpaVlpTP8DrAGdHzuuk+4YLTmDBk9jdOJCiRyxj2",
-	"PWw8q6jVPoDO4dGbEH57Owlb7eQgJJ3Do7DTPjpqdVpvOs1mEwdYAknOOVvhrpY5eKIyIdPYk/MHt/GN",
-	"tHme4u41XsDE5YwDHAuuCeUg8Y3Hj6YgnZMpyZnG3Xaw5/BCMBqv0CUF6Xda2KRcwwykMfpVcPdsVUuf",
-	"zfIz73WNcxUuQOnQPNbmd9vETjWk1mo9CbdApCQrvF6b8t7nVEJi7Fno7Aq6K4KY3EKscYCXIYEs3L6t",
-	"fZJ1UAbkGVW6Dsryw5vvbYC/SpjiLv4l2oE9KpAelaz+BSoTXEE9hQBzWOovGZnBFy3ugNeLeWmW0VRI",
-	"JEFLCg+Uz2xhzU1kbppKS1A506qCV1idZp/7w6Ph7fFq1L5qji8/HZx9vOqcfxzq0eXp3WjVmo8HV+2z",
-	"yz9X49tPy/Hg+GA86C1G/dO39Qbaq3alJrVaV6u6zb9WWZrUEx4Onmv1F/beVMiUaNzFeU4THyP8JJsX",
-	"kc0TXPJf548XMsSxlELW0RuLxFNLexjZvVK8nWbTVwHYWPbZSEEpMqviYsgfCKMJMr0IyhCAJClokAqF",
-	"6G+zZUuFfieUgRf1bsHv0e6V3c21zlQ3ioqVRizSyMasoglJwiKKSrNJGkqYggRuIf00jbj8i1M+GjkB",
-	"wlwrVWv/rxts7gy/pGs00bkHpi40VGzXba+82T8HuUecsVwStjVjTCvKZwy0ceuCNAs5I3KXyHptrFM+",
-	"FQ6aXJPYzLL1/pwf9EfogpEYUuAa9S6GOMCMxlAQtCNF3MtIPAfUbhhiySUrYWGxWDSI3W4IOYuKuyo6",
-	"G/aPxx+Ow3aj2ZjrlDmq0LYi+w4fQCoXzkOLsGxOWua0yICTjOIuPmg0rWfzjLb00f4QnoGuP4mZ34gw",
-	"hkySvfINa1zaj2FSnNw7sGsl3L3etzwiS5rmKeJ5OnEEZ2kGaWHmci4NOVFz8D4HaV6+qGNKlm68K/rV",
-	"yhMrDypKrGWoIXX2N1+UF1910lgH35YIGZlRvuFKXzglpVGOZR+mN6Yt3NC2xW43mxtUAdd7mii6VU7K",
-	"7+y9UBhZuWVxW03o/A+Dhs53dOoY3OPqHdlyqfF5+CN8DrkGyQlDTkIgKA4GWOVpSuQKd/F7cECuwN4I",
-	"C6E8uO9LIBoQsbivTswq7N3BXuXEk7g/tz8IQ8OBRdh30GU+XFqB9gwe7SO9E8nqNaDo3mk3morJv9cF",
-	"rddwvfv34GcneDphg21ewZ05U5kJ0SNN1q4zGGiPwhnY9XqPoAlRkCDBUc7pfQ5oOKi1jbv7ZNtYVFtV",
-	"UgV1FVJlkD/z/4mHhDs/Gn5jgfqFu/87DDfw8cBwvtWoXlFS6MR4DvGd5VCfAqvi7T3ok50+faVBfLIV",
-	"jj7WqSRfTsFdcJVy0HfyMMLrm/U/AQAA//8PPyrs+BMAAA==",
+	"H4sIAAAAAAAC/+x9+1PcONbov6LyvT98X1V3AwmT7w63tuoykJ1hNw82kMnuDClKbZ+mNbgljyRDeqby",
+	"v9/SkWTLttw2JBAy4Tdo23ocnfdLfyapWBWCA9cq2fszUekSVhT/3C+KnKVUM8HNv4UUBUjNAB/SxYJx",
+	"ptfm7/8tYZHsJf9rqx5qy42zFQxyAjmkWsjk4yShnAuNv9rRsoyZf2h+3JhFrwtI9hKlJeMX5rsMVCpZ",
+	"YdeU7NejELEgegmE1vNNSEGVgoxogY8KkbOUgZqR0yWQLF3NmNgihYQF+0CYIhIUyCvIZskkgQ90VeRg",
+	"1iCuOchkL9FAV1P6/9yTWSpWZkFuhWL+G6Ta7kyz80+ETk7nkH8KYF7gAFGYKNBEcMK0IhkUuVivzIoI",
+	"5RlZUZ0uISPzNcEVEOXWZB/jUG5jZ1yWOeAMQi9BhnM4ANOimLKMXMK6+roFcyqhBvoZb4KdFoXZ4FIU",
+	"ySSRwvyYpDRdQhTqnK7wqyYYXtEVRIAQTmTmmeIS5HR7J5l0IVtQvewOfUC54CylOTHP/SQSlChlCu0Z",
+	"KtBs7Tx5CrvfPfufKfyf7+fTnSfZ0ynd/e7ZdPfJs2c7uzv/s7u9vW02DDR7zfN1sqdlCZFVmSWzNLLn",
+	"E/ugZ9u8XCV7vybXMLd7TiZJKrimjINM3kfm0cAp13aaBS1znexVf7Wx7hTfJeKaM37RxbzrpVBAfi+F",
+	"poowTVJRIuZdUMaVboDM0lrsNDQD2d30sSHtNTllIKNIL+GCKQ0SMsI42TKDeDZg90LMT+R6CZyUXIGe",
+	"1XMzruECkC7/EBxUd/ZfzM8DePZrUqrpNSg9NUjm/35iYM40rOJE7X6gUtJ18vHjJJHwe8kkZGY8RPka",
+	"Ed63qWKSfJhSKKYVTiIqfZyEfP3vlOXiCmSEv+vuPt8Z8LT2SK6pIgvKcsiIsAi1EHJFEU+ohqlmuMzO",
+	"3mipxYpqlkbnQZ5iplq4FeI8WrKLCzzFOaS0VEDgCuSaFFJkZYrLqXmaW1U991yIHCg3ky+kWHXnfc6v",
+	"mBQcP25vM4dFE0XrOWO7MxOcb0SXngnGIgOyCCV4d/h3y/WIQ+oOL24GkJW4QtHaAMqcppdlER/+ZuAI",
+	"hr8lfeAZ476C2UO8myRUd8mmSSAvmNIR4qhfwP+rFY6U9W9AFYIriB0rhw/6vKAXcK7FJUTO99T8TBZC",
+	"EglaMrjyzNZ8ScyXhhVJUGWuVeN0YP2P4peDo2dHvz1fv3zydvvV6X+evnj3dvf1uyP98vQfly/XO8tX",
+	"h2+fvDj91/rVb//58Orw+dNXh/vXLw/+8X33TFvgbsBkAKrV/r9GtTK5I6Uv0MYiUl1TXVarqV814gxo",
+	"uiQGvUNa2bQAQ3GH1RAxJISa8DdzBSvTkbbIUijdFb3pkvILq1R6Xq4CTaTBRh37eD9C/6nH6qzPC7W4",
+	"/ivyDJQmCyaVHguwmMT82LvICoos6y7u6HBIIR2pIVZStixZFuO4d2dEJI8K+CQpcppCnEAOKM+YUX4c",
+	"YYhFbX+uJ0RSfgnZhFwzvXSLNpLc0rGR1NbsMrJaEi70TcjaMhSzjKjGgHCIkMwb94TIkjdYyoSUPAel",
+	"UG2QLMsAn5tH59VwvdAKVOfb2Stdswf5YHeU/YsLCRfUAE01WGUwXmjvNvDDrH7dP9v5CpSiF5G1H4Km",
+	"LFeEzkVpWV/v5MmAbTXajvpcRlGvgXMeoskA6wg+3YxQZnJLDCqlOZphGbtiWUnzfN2PQDV/+eKm182N",
+	"q0q4d7G1fkkRCQuQwFMrIymvfCzEuFgm5kcjNGTlllEz8s6wDv/exFlBBkQOFGe8oUuXSlvpXL+x+r+W",
+	"/1Cu2bQeiZs3VnRt3TG9Wu85y1RMtsWOQoX8a1BqtXkW+qTOP5csay/NgobmOVnSK2g6oAZ8Th83q7gn",
+	"bFXmPb7TtJQSuK4NovFGH8tGQdFL484DUepUxCT188UCUu2PL60kmHeaEsE3+JNK7tS8ZJKg5WbOnF/R",
+	"HJcHUoq4d+kmNixTxA1piCEVZZ4Z6UjmxgtA89Iw/ygTtycB2c0M0GucYA4EBb1hWBPrGrJeonp34w+v",
+	"5ve9HLzNV1tGFk7oHD/4SX2ikxZaxUywHwwxHUigGo40rDYatjfQift03deFpVTDv4y92sWfAUTuNzGH",
+	"tvcGfi8hZrpXp7WBI2tBUhxlQqgmK8M4d1ApHKWGtYEcZWofzlPB7YGl64Z3dbfNul7SD2xVrggvV3Mr",
+	"yXEZpJAiBYxvME4KKmmeQ57g4Ob9ZG/n2SRZMe7+iYn7lcigMXnCeAYF8Ax41717bCfEY5ybTRrGORVy",
+	"yoVeGk1FSCeJzAJ9kEFch4yiOT7VYsXS5P3QwVu49x75IeSw6chvIKvM2Wc4XO/Z30KEPZ72jU4723DW",
+	"hqTeoG/rc3Gv0BlnJVXEPEIDzYACN5sxK3pUmaYQlzo3sf0HMcrA8UNMwVeoC/lRcW1O2ZaOGqK2WI8R",
+	"9dqKknC44DDdZnG7lWtdijyH7Nw4bpJJoi5ZUUA24ohxQ9VKek+731HoVtD1OLToRi+p7j+vACjeYRo5",
+	"fPPAjIhWsRl24qEsZFYbVzXIx8uJAJ0jnKMG+bh9hkc0oEnU/uHIucbO44DJtGT6Bwn0MhYwMp+WEtSm",
+	"pabmLNNSsyvw4YiU5nnDGt+OHc6wd6mQQluniWc56bodr7liGci9OnoZtfcj8xxYxQqN+2rC1MKDzB1A",
+	"akJJc6EQmqIAnkySJc0XU/x7kC58TA/XETuFQ6DZC9A6HrLTsCr0xhPIIGfItKuXY+C22k92HgsCnrIV",
+	"IAC40GwRBpgu2BVwUhYhR9sYAoQro65u5pQld0uGjBzkosyeX1lp0jOa/bmz6HUBgyPWuMLEzKQqVC6+",
+	"WcCvZw44Y5n+ieEKPIVAolsXPs1Ibk8ydgQ5Vfq8Rxw9Nz/7gcyLnWONu1HXuaCRFb6NAmRC0BPJtU+f",
+	"uYb5UojLZFh8J8HRNs5lM0bHY20GUOcWUONjbfWgg2HCxvixBT73h9Ay4ittqns0+CxAqN3tKFfbeLze",
+	"6RgMkxw5+9dJGtQDV2BWTqbkZ/PIUuPfW5Hvtm4anxGfhdMttS7U3tZWkPK0hWtWW3OaTWt5Vyswkk0r",
+	"f9Ygptj9u7eioL9y/vUWo4t71d4qQ16SqLUyihBKxDpjwKAwdCidcsHXK1GqGLCaDq+R+lw9EZGQUw3K",
+	"RrEHlbxRTNcObLithFRIJ7VHcdva+x3dTB0Q7NvBp7C7DuR3n0RNlD5P+0/livKpBJrReQ4keNieocv3",
+	"nOCP6M/uyegNO4wfQIb946OKQjcg4UjaDEVXBH/HCSaMDkcdXyP3HuPxjl8EeNtLw3HWjtOOZ+o40r2k",
+	"TGzevFt2bLM+Otzri7ihwzPI2ZmQSygw3m/s7CVTWsho0EpTeQEDYXstMMGmM6UWE/zNIAbTZgVc4Jx1",
+	"Ztxw7L4LvQ6cfgKa62UXPE6pPndKdU8iRJ8OrrwVYI5XlHouSh7YA2aOkbZZy9iJ4NwnB6WXFgRjUj17",
+	"zHULRBdyjIy9TobPohvH+jMp8lLSvBrGDK0Yv8hBC+4XaX4ocyrrjZixj73CfAgpUyyG6KdVIJxk7iXL",
+	"JJ2bnfIQIQdiUKPccU25OlZW2qUNcPpqB84d8Pp4v/4tFxeqETLQS1hjznPGlJFi0dXChyKnvPJhtUi4",
+	"fliLBAx9uARb4AQ4jm2je4cHL89fH++fP//38Yv9o1ch+scDaT3R104YihelHhqlBTXzSTMVIpZH4p50",
+	"ZXVATw5/wqhPjfuagdyJC2/vNRy/6Nr3s3nVEq568P2Ne9Ja+7zkWQ7ObbOiGTTwqYE2hgMbww0yYlIa",
+	"/afj8wDGJwD0x1usKedCThUcLBpUoI1JxIolHBsYwXVEm89zcQ3Z5jRgBzZ8V/XvJEjx3ZhOF2h+VLtQ",
+	"H+Yg3UUy3V1lCvkA5d2lC23WVdyZSPjNTn/fiSqfEMq928x8j9Fuv1GywC1vShL41BzjYOzI2VozfpOn",
+	"sBkVM2QyFHIPjsdHx8eOX+HQfN2Tf5DEI1pXMH6S6vRdyZLPCO+OW6czjB37EqBwRgXrH7mFJyOyJswo",
+	"g5nVbWQKrI9WlLsNWC+IOnpWRjW9mag8pJp66rWDogfR2StSCE3MoLPfVDyBdCUyU1H2CTk+J6jBqlrr",
+	"vhBkwXJQzWVhJjLLgThne5Dyg7rDzHyY7CUFTS+NZYg/nvHZbBbPAgpP1G8idkr/KoWFaSszia2YrpbY",
+	"QCqDZMQmjUzcPwzkhAhZ/4eygfHqxRl5azworrjqjFtq0jQXF0QVkOKoNm4bskjBUyCFI4oZeYvqR26X",
+	"RiWYLFDzj6/Ta1ltwxr2oJETV+YHPzOx9TanHAqoN94PguZRH62ZIC3KMeMeHL8lqZBgk2WDpLxRk0i6",
+	"6p/kR/aDmeHN/svbjd2b+NvdBeNKU57eYhcD6ax66Yr/7AGgo8mnLtpPW16GW1cDoiKxcbpmsZ8hqO3B",
+	"7ZXeM7lJACORIwXGHR/4PO4Nw8WOF/U40qAq4gbt5Udv/aY2Kx9daDia6D5weBx5ECBhVDA2Sb23tsjO",
+	"bScKRo1t8SSlOWzwxQ2mw2tRZcRXdDAh5kOaeh+iHwYrqz0LDfH36VBiTqXM9gm+zZ8Prl2JVcvAaFbE",
+	"2NR+s58gpT8Qi6WaAkX9eG93XCLsSTkP1vTnRnnRE+lQwRAY8Kjd27eTMHUcUsWk8ALSdZo7X7jPA2Po",
+	"eLVn70Iv6GNZFXrdtCHijvjwV5tWlt3ErhhKIwphFAkxjSgiT2XMVfxPWPspfnq5fzA9+Wn/yXfPiGIX",
+	"nOpSgo0LO6fXv6eHBy+nJ9WzJdAM5Iz8CBykgUPtxlBgFBmeG6NRl5L7Z53zZuFxd5Zdyjzm3s4KwTCM",
+	"kQKrPfxBloKyhvTx65NTH6BR0YCniXOrWRj2zNLVYFzALOv9ADHEWX+49/ESIBx3UBA0p4gt8zQqS/dJ",
+	"5b6wYtP3cmg74TC8gupik1l/flWxyl/scxg1lGhz5KLUXl2mMnADEL1kCn+fkTfI1J33oDDOKlEq3x5g",
+	"1uNiajC5djy1E6J07rNRcdRXjfCp1fuNgKGq+qGn1mK83LFHeB4PZUQcriX3wVJ0dBuDb//4qON9fZr0",
+	"96tQvQVgiji3iecrmtW8133doyD+2uxhcUuvDbLMECR9RBKnYbPc8bRrxhlckR0ytox3JnewLzvik7Ng",
+	"fTDo3BTRRpH7Z/ugisOhG2NCGDf0bWJDltPaw7NPQ/nEuH62G/W8DMfAq9FuGATv5j8291jFs4eqDH6J",
+	"xtL30Z2gCmpLGSnxaQdNbpRS3nZEttOKZCb4kDscS72MkHRvI1e2gg8U4YJwuB70kN8mMLaxMPxnmpfV",
+	"OQVvum5C7kEFpxu00/ABzc0xAg/xnCnvj0ZAUV1nyqk1T5dScPZHP2BYtjnXNL6DWku++2LscAUdBMXU",
+	"QQXAowr2iwgUEGJeY3Lmyzh86E+uaSXn1ifj54lDcUN2roSLnhDbRcCLrImGkTLnBcQNxsFHnM7SdgX6",
+	"wxyXCFMBYRJE3itC7uMhQdAokvS4MVcrjECHnSBCWMfD2sosfIM/qFY7ZGVHtqapSni1IP+1wGxHJ3zV",
+	"fyefktSlenpcRPFuSVVkxGPzs1m6kEE0IQBJfcKy5NwM9ulIjcpJk3hG4jPN1iPPgmbr+kRGQX1DylcM",
+	"wqP4WAv5cYpe9JaU9eR/wBVNy0YWQ9ft7QD5GWJi1WJaFYttU9t8ihb6jQTirYSo3b8V8t2Tq0tbus9G",
+	"5rzUKUM+V6tG92qfsXqPeggtNM1vEVurpG5l2WcG+Ia6ZV+40OPqCF7r8KJKerLLDGEaVCcNRs6iuLFJ",
+	"ox6bdNST2h1L82uHVdPeSubequ7ucRfAM3vcEcC878kQHR9Qp+ozNOjqpJcE1S9l/3nFza9uLf0QTxhc",
+	"X38ddTN14sZJnpY8oo1eAvlE+SXZsXaUSoUEsj37fs/2LbIjMO61m15t5WZKkyqMoMEqLW2IwjhzrgTW",
+	"G5TKuocv2k6GZMPcuOyIwY+7sdVC83Uj4WXJLow2zxS2CAUpW/5eUc7zgKXaqBVO5SC52UJoZddaAdhk",
+	"W1GDwPOnWwtJD4tgoVV+TRfBPmIGxUJYe5BrmhqE75gEhwcvSZVe5TwxOUvB1UtabpHsFzRdAnkyMxEu",
+	"9JxWbs7r6+sZxcczIS+23Ldq68XRwfNXJ8+nT2bbs6Ve5TbSpnHj7QkrF0FytUPzYkl3zNuiAE4Lluwl",
+	"T2fbOLPxqCBxbLWFeDRZ2RA59uUwm9xvOrgMqeE/R5l7s/VCXRaT7P3aSbkSqxUlCsxLhvTyTZ1BTFcQ",
+	"TGO4hPXfrqx5K80/Rvl1jmw8avz+EtZtv8Tf1FIUEykQa5mZ//cSMG/bHQ9Of+4b7SKiGBYVlYZ/jqwV",
+	"18L513vmNCFhTJdX7A9oTFm5Vne2t8Pa8u3tzb7Ej5P+lPuCXjDuje3YcoLM/U3bx65w1kmFePNke9sT",
+	"iLOhgvPb+s1x4Hq8kY4wFC9Igq3i6H8axN79jJPawrLIVD/QqsTLzPndfcx5xDVITnObBikJuBcniSpX",
+	"KyrXyV7yI1iabFAw+pFjyUa2BQahSMJNX1STgu2L+403NpLwcF+RG3fTi+El6iQ3IMd3lGlScs3ylnkV",
+	"5NxYf5QE4vRxMoeFkODo1WpssbVcU6bPF0Ke+15lEZpd0FxBV3yN4RsKUsEz5Bxmpgqq4R60MF13xQqI",
+	"X0Nsodao1WwFotTxdT69OTexHT66eugSup3MjZJruLSBMhfaGc9GxLMVTGzu3zVTgDl7hJn0a3lpXKiq",
+	"7hgc3ZnIc1Pkci74uSt2j++vEbaqj+F9VT/2g8jWd8G6LF3XyodrSdbimjt3MXUdPnhInHN3++ndz4l5",
+	"NAQ+uCYKD4lhexbcrKkx7zS0sK0/WfbRorAhs1gsM4cYKydzqoz1YmJy7PcSyNFhh7vbbzdyd6Q2DLk1",
+	"eW8Tk0NaG+ob1dUVdu8b618JcuCm+9b1Bo8+LTScxFV/1DJug2k/gv7iaLb9pZjr7t0f9SthFIOSZw9P",
+	"Ke1glitPa/s8rJ7Qet9IfvvivI4ZODqaVJn9Rs04OsRAq/nTO+PtLRK+PIlnNuPaXXJBJbQSZDQWHV+H",
+	"BexYcesTDJuBFudctldkeFWx096TKVIWmXnRLsZgLcjm7S4SXFuzDMOgE6JER5syO1VeMT3jlf/WpjtC",
+	"daeCsjngTeJz6TNfggAfgE61/RfWqXzTkWbOqIyWgLH7U7tOo0VeVg0j1FLgN84aHU2O0/+26m4M/R45",
+	"ZBllxrRPk/VdSJC5daR2s9v+jDy3H4lSm8zaWOeUNZlDLviFDSxs9PTZwe6Dy3TM0dd1CqsHhFi4ZMJ2",
+	"Ex1LIjNPIj3Gpfvs0fn3BZx/dYeSR7dfjI1UlF+j+jiOUqkN0yzoB9Gr9OsbtYZoVEVHauwZVLfWnXFo",
+	"Nk4w6aths4QZ8Q0rrKqEnVboQveFjVCRimlBP4Lu9sD4yg2R7oYezZC4GYK4V6nKFQ6PpZe9RXgbW9Sn",
+	"/tI37Wk5l5vjkznoa4AqH8zfjeZT922rnroiyNgzNgn+1BCLo0GmAtvFWiDegrEthsIUz0lnVYaOvP3C",
+	"eGX44KQTtJJ4bCf1RRE5LHRouDjjxsGIsED5cOO7pkixOxDslQmuDgBXP4leOaZ8t8Ngb41OSDGq912f",
+	"/hrGT7uH1UdnAX0zBs9pjeHu2Clv4vqA9XPfTHB3+/v7t7eYKdiwsZVu8ldFyl1O8aC4tsH1NvO0t3vF",
+	"OTRmvvaz5xMnBPhQFq1LcqxyOBsLkCWfNEtODcJVxfln/DTwMDWrsZDpLiG99A2gPNut1oGWmHM5NdfU",
+	"DFKecXu1W/WhrbsInE2+tYPPg/X3qWKKsStIaKStVuPXc55xWgU/DYf/mUld0pysaLpkvJWRIUtOKLGt",
+	"yMhV680APB3+jGXAfw3m3KhofnRNVf1wLYr1cma9hNW9eqYqpH90S3WYLuLwoEq8N6/vUennuPY5UYax",
+	"0Lztf2p65xsKrVFlq37+NRv12jtymzOOPBTbSEJGgjs8ZuSIE3ufBVmJDIi/FCNw2ruL84I7MvA6ehUm",
+	"PJ5xP3OD03nB6ocK3fdotwb3ePW75IOLaFoJcnfBmSKX79wzf2reGBFBzmOQUzwI3+n1W/fs+PyAEPdM",
+	"oKnML/sI8rBKEYgTpH3eS5DkNhTlUP+MN0gqGJbAB6Z0PxF0chDulAia1xE9EsFXkp0wjgiKoI1llACe",
+	"OxET6iFdD41VjwshdWAzmR+9Nq0I07E76KxMMo4MFA6GQihfI6nMyP6mnnqBiVAVyCE1+rp7BdgYGtMI",
+	"Y6TkOng2Fem/WPi10670MSQQoxkHnZbHc4Sjc+/a8KfeMMCJlkBXQZ0/+uOaupEiJ/a26BMzp40CWj3P",
+	"dugx9puRGvUgGJQ74/8V6RMwCQefOcu2+aNXvESYUjHDAtRzO37239ah6RZwdOgyRGyHAeJKJColExtn",
+	"UFL3cKidk+2uBNZJqRAqZ9xw7BWoIDJhirmnOMb06NB13rHNeeZrCxrbFJGkOavitZzbFukxGsdF3aSc",
+	"4g2uqVoS6+57QkqF1/ziiuvSZSzH9zcPYOeC3szbbq+GiCHum0ps6uI2IlCi4YO2sfCphXuTpNp2/pd2",
+	"VEYxwLstO2eBS9vZvi8buEUAuCxiwvsgCb2iLDfRtwfF2N7Zmw4bBQ6Gh90qOSLWabOZEhHNcehLbBid",
+	"g9CshYhRVKf08p6SIeKdWj5PQkRkHfXFK/O1A42rsooC5eYVWJE5q6DU9ZLlEDQHYKoS5X1srrpd5rMt",
+	"gmJzy4A7u5r02PyK2UubIriwoZz9Zuupchg3LwWraD7DUh6TZB6TZO4xScZJCZQYy+qKm6jEcJe3YLAG",
+	"kSBWzdtJLvmpvjTmjs75J3+bS/SQG1sOt2D37BNv9vyd6yPMZNfgTQuMGoUmskk5UEaL6Wla7qxXTmA1",
+	"h8xwF+feFXJiU7GdhX29ZC2h7ixrrAALWrZP3K1IIuwQbxjoHCpzekZeBb5eq//PzoJ2+8qZ2ItSmR3N",
+	"zYjmHpaTo5dvX+yfHr1+df5y/9/n+8fHL44O8P+TloXDMwOPooDMce7W56dHL5+/fnsaDXU5wB/XbfPv",
+	"wkLv6zh/39Z6axkPQB93qJkJsDq4+Z7l4CNT0lZ/roSEzg0LNrrp9+KRGdTDCt44BOsnS8sK6m7WmxVm",
+	"+14VrGaSpO623dJdf9lVkP9lx75DxKrbdPdi1MOSPA7cw8XY+CK6SyR6Sfwl94K7s8BIuuvHjh4LkxjW",
+	"U7ONYLojFmPHvueK1mDSGPyyL1fIeg8ZPvsOAXz00QZV6qS/Jk48yECWj6/XHChS2xqrT/WY/KUqUzfX",
+	"jn6zqQJV4XGVN+GkSUcz/oIHuH337OcxvRp0iAU95Z223Amdy9WtOu6ric1ybvIwo8KgkuZ7CjfR6i2G",
+	"A+4Xs76oIL1HTP4SMvSbpR6LyE3x2LlzYLOeLuGCKY2+1GuY4wUJUd38pDHsHWJY51aFr0RTb8K9V2F/",
+	"4+BNqId3cLHFQS7KzBVTNi+4aPblJHl1rYkLp/Yo8ifNi0TuJHM1nOKe1fru3A9Ku394arRqAqzNLgYb",
+	"xpxoUfj7cwzCNpFUixqre/rFtBDyUS1/mGq5al1Bs6GhS1eAjOzp8uVRYfveGNGjng+6g1Zx9rOVAc2m",
+	"OWjt7l3ZrL+0OFDnPlvHrCBrcCfnf7cxCglarjsY2lZ6DoFmL9yqvnJsrbcyFPj7ZvEV8avkNfY08Mzi",
+	"rmajMBRfCy6vZaoP3U6ZRa47O/jqlqOvRKe2EB6jS9sy5yOtfMwC2/zObZ6GzRN5fbzf5+8+tRd43YV6",
+	"jEPfs1pcz/ntObtPHcWFday1ivLA2vg00Ncylarf/Wamgq8F1/1AVl+NU92Co9pXmsRNe7wL4C7ZTtXd",
+	"/ythO/YM6uOozKINarB50XAZd9dBR9v9xd178ql6w33qCbjmR+2gT5v9w4KngSVbmb8eZ3M7HCkuJCjV",
+	"yJnGT8NeGX14ZK/g+QqRyS78y2LUaXhRBzcWgAU8PMy+MxVSxPFtz15HtqF1gRYF8ddat24PVGFrB6s9",
+	"NZ76lkkRtQlnfeRpfyGeZo+0D80qttaThmI/rm9m5Fl15VHn8mCPdaZd0YU0gGiUc8ey68945CKqSCMj",
+	"X0PH9KTR3nS4gdEZd5mCt2lg9HdhSu2anN0shfz4/JR0pEMsvw854/3R05P7Yev7aQpFZXH8ZdvneGz0",
+	"9sYcDLN9iFIFT6qPxks+JEwwj3mjFLF02BUYb93YjyLjrxR+dodaI5S7ENyfq72Waiv5+P7j/x8Axed2",
+	"v5DCAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,17 +4,90 @@
 package v1alpha1
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ApplicationResponseEnvironment.
+const (
+	ApplicationResponseEnvironmentBackup     ApplicationResponseEnvironment = "backup"
+	ApplicationResponseEnvironmentProduction ApplicationResponseEnvironment = "production"
+)
+
 // Defines values for ApplicationService.
 const (
 	Container ApplicationService = "container"
 	Webserver ApplicationService = "webserver"
 )
 
+// Defines values for ApplicationSimulationOutcome.
+const (
+	ApplicationSimulationOutcomeError     ApplicationSimulationOutcome = "error"
+	ApplicationSimulationOutcomeInvalid   ApplicationSimulationOutcome = "invalid"
+	ApplicationSimulationOutcomeMoved     ApplicationSimulationOutcome = "moved"
+	ApplicationSimulationOutcomeUnchanged ApplicationSimulationOutcome = "unchanged"
+)
+
+// Defines values for BatchCreateRequestMode.
+const (
+	BatchCreateRequestModeAtomic      BatchCreateRequestMode = "atomic"
+	BatchCreateRequestModeIndependent BatchCreateRequestMode = "independent"
+)
+
+// Defines values for BatchDeleteRequestMode.
+const (
+	BatchDeleteRequestModeAtomic      BatchDeleteRequestMode = "atomic"
+	BatchDeleteRequestModeIndependent BatchDeleteRequestMode = "independent"
+)
+
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemResultStatusFailed     BatchItemResultStatus = "failed"
+	BatchItemResultStatusRolledBack BatchItemResultStatus = "rolled_back"
+	BatchItemResultStatusSkipped    BatchItemResultStatus = "skipped"
+	BatchItemResultStatusSucceeded  BatchItemResultStatus = "succeeded"
+)
+
+// Defines values for CircuitBreakerState.
+const (
+	Closed   CircuitBreakerState = "closed"
+	HalfOpen CircuitBreakerState = "half-open"
+	Open     CircuitBreakerState = "open"
+)
+
+// Defines values for FailoverRequestTarget.
+const (
+	FailoverRequestTargetBackup     FailoverRequestTarget = "backup"
+	FailoverRequestTargetProduction FailoverRequestTarget = "production"
+)
+
+// Defines values for ZoneDrainApplicationStatus.
+const (
+	ZoneDrainApplicationStatusEvacuated ZoneDrainApplicationStatus = "evacuated"
+	ZoneDrainApplicationStatusFailed    ZoneDrainApplicationStatus = "failed"
+	ZoneDrainApplicationStatusPending   ZoneDrainApplicationStatus = "pending"
+)
+
+// Defines values for ZoneDrainStatus.
+const (
+	ZoneDrainStatusCompleted ZoneDrainStatus = "completed"
+	ZoneDrainStatusFailed    ZoneDrainStatus = "failed"
+	ZoneDrainStatusRunning   ZoneDrainStatus = "running"
+)
+
 // Application defines model for Application.
 type Application struct {
+	Affinity *ApplicationSelector `json:"affinity,omitempty"`
+
+	// Annotations Annotations of the application, passed to the policies. The dcm.io/ prefix is reserved.
+	Annotations  *map[string]string   `json:"annotations,omitempty"`
+	AntiAffinity *ApplicationSelector `json:"anti_affinity,omitempty"`
+
+	// Labels Labels of the application, set on its deployments and matched by label selectors and the affinity
+	// rules of other applications. The app-id key and the dcm.io/ prefix are reserved.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name of the application
 	Name string `json:"name"`
 
@@ -24,7 +97,10 @@ type Application struct {
 	// Service Service of the application
 	Service ApplicationService `json:"service"`
 
-	// Tier Policy Tier of the application
+	// Tenant Tenant owning the application, whose quotas it counts against
+	Tenant *string `json:"tenant,omitempty"`
+
+	// Tier Policy Tier of the application, registered in /tiers. The default tier when unset.
 	Tier *int `json:"tier,omitempty"`
 
 	// Zones Zones of the application
@@ -34,6 +110,30 @@ type Application struct {
 // ApplicationService Service of the application
 type ApplicationService string
 
+// ApplicationFailover defines model for ApplicationFailover.
+type ApplicationFailover struct {
+	// At When the application was failed over
+	At time.Time `json:"at"`
+
+	// Automatic Whether the failover was triggered because every production deployment failed
+	Automatic bool `json:"automatic"`
+
+	// From Environment the application left
+	From string `json:"from"`
+
+	// FromZones Zones the application left
+	FromZones *[]string `json:"from_zones,omitempty"`
+
+	// Reason Why the application was failed over
+	Reason *string `json:"reason,omitempty"`
+
+	// To Environment the application moved to
+	To string `json:"to"`
+
+	// ToZones Zones the application moved to
+	ToZones []string `json:"to_zones"`
+}
+
 // ApplicationList defines model for ApplicationList.
 type ApplicationList struct {
 	Applications []ApplicationResponse `json:"applications"`
@@ -44,25 +144,209 @@ type ApplicationList struct {
 
 // ApplicationResponse defines model for ApplicationResponse.
 type ApplicationResponse struct {
+	Affinity *ApplicationSelector `json:"affinity,omitempty"`
+
+	// Annotations Annotations of the application
+	Annotations  *map[string]string   `json:"annotations,omitempty"`
+	AntiAffinity *ApplicationSelector `json:"anti_affinity,omitempty"`
+
+	// Deployments Status of the deployment in each zone
+	Deployments *[]ZoneDeployment `json:"deployments,omitempty"`
+
+	// Environment Environment whose zones host the application, changed by failovers
+	Environment *ApplicationResponseEnvironment `json:"environment,omitempty"`
+
+	// Failovers Failovers of the application, oldest first
+	Failovers *[]ApplicationFailover `json:"failovers,omitempty"`
+
 	// Id ID of the application
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
+	// Labels Labels of the application
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name of the application
 	Name *string `json:"name,omitempty"`
 
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
+	// Placement Candidate zones of the policy, ranked, with the reason each was selected or not
+	Placement *[]ZoneSelection `json:"placement,omitempty"`
+
+	// Replicas Replicas run in each zone, unless overridden in zone_replicas
+	Replicas *int `json:"replicas,omitempty"`
+
 	// Service Service of the application
 	Service *string `json:"service,omitempty"`
 
+	// Status Aggregated status of the application deployments
+	Status *string `json:"status,omitempty"`
+
+	// StatusMessage Details about the status of the application
+	StatusMessage *string `json:"status_message,omitempty"`
+
+	// Tenant Tenant owning the application
+	Tenant *string `json:"tenant,omitempty"`
+
 	// Tier Policy Tier of the application
 	Tier *int `json:"tier,omitempty"`
 
+	// ZoneReplicas Replicas run in the zones scaled individually
+	ZoneReplicas *map[string]int `json:"zone_replicas,omitempty"`
+
 	// Zones Zones of the application
 	Zones *[]string `json:"zones,omitempty"`
 }
 
+// ApplicationResponseEnvironment Environment whose zones host the application, changed by failovers
+type ApplicationResponseEnvironment string
+
+// ApplicationSelector Applications referenced by an affinity rule, by ID or by labels. With affinity, every zone of the
+// application must host one of them; with anti-affinity, none may.
+type ApplicationSelector struct {
+	// ApplicationIds IDs of the applications
+	ApplicationIds *[]openapi_types.UUID `json:"application_ids,omitempty"`
+
+	// MatchLabels Labels the applications must all have
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+}
+
+// ApplicationSimulation defines model for ApplicationSimulation.
+type ApplicationSimulation struct {
+	CurrentZones []string           `json:"current_zones"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
+
+	// Outcome Effect of the candidate policies on the application
+	Outcome ApplicationSimulationOutcome `json:"outcome"`
+
+	// Reason Why the application is invalid or could not be evaluated
+	Reason *string `json:"reason,omitempty"`
+
+	// SimulatedZones Zones the application would be placed in, unset when invalid
+	SimulatedZones *[]string `json:"simulated_zones,omitempty"`
+	Tenant         *string   `json:"tenant,omitempty"`
+	Tier           int       `json:"tier"`
+}
+
+// ApplicationSimulationOutcome Effect of the candidate policies on the application
+type ApplicationSimulationOutcome string
+
+// BatchCreateItem defines model for BatchCreateItem.
+type BatchCreateItem struct {
+	Application Application `json:"application"`
+
+	// Id Optional ID for the application
+	Id *openapi_types.UUID `json:"id,omitempty"`
+}
+
+// BatchCreateRequest defines model for BatchCreateRequest.
+type BatchCreateRequest struct {
+	// Items Applications to create, at most 100
+	Items []BatchCreateItem `json:"items"`
+
+	// MaxConcurrency Maximum number of items processed in parallel
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
+
+	// Mode Process the batch all-or-nothing or every item on its own
+	Mode *BatchCreateRequestMode `json:"mode,omitempty"`
+}
+
+// BatchCreateRequestMode Process the batch all-or-nothing or every item on its own
+type BatchCreateRequestMode string
+
+// BatchDeleteRequest defines model for BatchDeleteRequest.
+type BatchDeleteRequest struct {
+	// Ids IDs of the applications to delete, at most 100
+	Ids []openapi_types.UUID `json:"ids"`
+
+	// MaxConcurrency Maximum number of items processed in parallel
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
+
+	// Mode Process the batch all-or-nothing or every item on its own
+	Mode *BatchDeleteRequestMode `json:"mode,omitempty"`
+}
+
+// BatchDeleteRequestMode Process the batch all-or-nothing or every item on its own
+type BatchDeleteRequestMode string
+
+// BatchItemResult defines model for BatchItemResult.
+type BatchItemResult struct {
+	Application *ApplicationResponse `json:"application,omitempty"`
+
+	// Error Reason the item did not succeed
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the application
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Index Position of the item in the request
+	Index int `json:"index"`
+
+	// Status Outcome of the item
+	Status BatchItemResultStatus `json:"status"`
+}
+
+// BatchItemResultStatus Outcome of the item
+type BatchItemResultStatus string
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// Failed Number of items that did not succeed
+	Failed int `json:"failed"`
+
+	// Results Result of each item, in the order of the request
+	Results []BatchItemResult `json:"results"`
+
+	// Succeeded Number of items that succeeded
+	Succeeded int `json:"succeeded"`
+}
+
+// CircuitBreaker defines model for CircuitBreaker.
+type CircuitBreaker struct {
+	// Failures Number of consecutive failed calls
+	Failures *int `json:"failures,omitempty"`
+
+	// Name Name of the protected dependency
+	Name string `json:"name"`
+
+	// State Current state of the circuit breaker
+	State CircuitBreakerState `json:"state"`
+}
+
+// CircuitBreakerState Current state of the circuit breaker
+type CircuitBreakerState string
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// Attempts Number of delivery attempts
+	Attempts *int `json:"attempts,omitempty"`
+
+	// CreatedAt Time the notification was given up
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventId ID of the undelivered CloudEvent
+	EventId string `json:"event_id"`
+
+	// EventType Type of the undelivered CloudEvent
+	EventType string `json:"event_type"`
+
+	// Id Sequence number of the dead letter
+	Id int `json:"id"`
+
+	// LastError Error of the last delivery attempt
+	LastError *string `json:"last_error,omitempty"`
+
+	// Payload Undelivered CloudEvent, as sent to the webhook
+	Payload *string `json:"payload,omitempty"`
+}
+
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	DeadLetters []DeadLetter `json:"dead_letters"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -75,8 +359,64 @@ type Error struct {
 	Type string `json:"type"`
 }
 
+// Event defines model for Event.
+type Event struct {
+	// Actor User or system that triggered the event
+	Actor *string `json:"actor,omitempty"`
+
+	// ApplicationId ID of the application the event relates to
+	ApplicationId *openapi_types.UUID `json:"application_id,omitempty"`
+
+	// CreatedAt Time the event was recorded
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeploymentId Deployment the event relates to
+	DeploymentId *string `json:"deployment_id,omitempty"`
+
+	// Id Sequence number of the event
+	Id int `json:"id"`
+
+	// Message Human-readable description of the event
+	Message *string `json:"message,omitempty"`
+
+	// Provider Provider the event relates to
+	Provider *string `json:"provider,omitempty"`
+
+	// RequestId ID of the API request that triggered the event
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Type Type of the event
+	Type string `json:"type"`
+
+	// Zone Zone the event relates to
+	Zone *string `json:"zone,omitempty"`
+}
+
+// EventList defines model for EventList.
+type EventList struct {
+	Events []Event `json:"events"`
+
+	// NextPageToken Token for retrieving the next page of results
+	NextPageToken *string `json:"next_page_token,omitempty"`
+}
+
+// FailoverRequest defines model for FailoverRequest.
+type FailoverRequest struct {
+	// Reason Why the application is failed over, kept in its history
+	Reason *string `json:"reason,omitempty"`
+
+	// Target Environment to move the application to, the one it is not in when unset
+	Target *FailoverRequestTarget `json:"target,omitempty"`
+}
+
+// FailoverRequestTarget Environment to move the application to, the one it is not in when unset
+type FailoverRequestTarget string
+
 // Health defines model for Health.
 type Health struct {
+	// CircuitBreakers State of the circuit breakers protecting outbound dependencies
+	CircuitBreakers *[]CircuitBreaker `json:"circuit_breakers,omitempty"`
+
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
@@ -84,8 +424,299 @@ type Health struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// PlacementDecision The policy decision that placed an application
+type PlacementDecision struct {
+	ApplicationId openapi_types.UUID `json:"application_id"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+
+	// DecisionId ID of the decision in the OPA decision logs, unset when they are disabled
+	DecisionId *string `json:"decision_id,omitempty"`
+
+	// Explanation Explanation of the evaluation, when enabled with DCM_OPA_EXPLAIN
+	Explanation *[]map[string]interface{} `json:"explanation,omitempty"`
+
+	// Input Input of the policy
+	Input map[string]interface{} `json:"input"`
+
+	// Policy Path of the policy evaluated
+	Policy string `json:"policy"`
+
+	// Result Result of the policy
+	Result map[string]interface{} `json:"result"`
+
+	// Revision Revision of the policy bundles that made the decision, unset when not loaded from bundles
+	Revision *string `json:"revision,omitempty"`
+
+	// Tier Tier of the application
+	Tier int `json:"tier"`
+}
+
+// PlacementPreview defines model for PlacementPreview.
+type PlacementPreview struct {
+	// Allowed Whether the policy allows the application
+	Allowed bool `json:"allowed"`
+
+	// Deployments Provider that would serve each zone
+	Deployments *[]ZoneDeployment `json:"deployments,omitempty"`
+
+	// Placement Candidate zones of the policy, ranked, with the reason each would be selected or not
+	Placement *[]ZoneSelection `json:"placement,omitempty"`
+
+	// Reason Why the policy rejected the application
+	Reason *string `json:"reason,omitempty"`
+
+	// Tier Policy Tier of the application
+	Tier int `json:"tier"`
+
+	// Zones Zones the application would be placed in
+	Zones *[]string `json:"zones,omitempty"`
+}
+
+// PolicySimulation defines model for PolicySimulation.
+type PolicySimulation struct {
+	Applications []ApplicationSimulation `json:"applications"`
+
+	// Errors Number of applications that could not be evaluated
+	Errors int `json:"errors"`
+
+	// Invalid Number of applications rejected by the candidate policies
+	Invalid int `json:"invalid"`
+
+	// Moved Number of applications placed in other zones
+	Moved int `json:"moved"`
+
+	// Unchanged Number of applications keeping their zones
+	Unchanged int `json:"unchanged"`
+}
+
+// PolicySimulationRequest A candidate policy bundle
+type PolicySimulationRequest struct {
+	// Data Data of the bundle, as in its root data.json
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Modules Sources of the rego files of the bundle by file name
+	Modules map[string]string `json:"modules"`
+}
+
+// Quota Limits of the applications of a tenant, of a tier, or of a tier within a tenant. Usage counts
+// the catalog specs of every application once per zone. Unset limits are unlimited.
+type Quota struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// MaxApplications Maximum number of applications
+	MaxApplications *int `json:"max_applications,omitempty"`
+
+	// MaxCpu Maximum number of CPU cores over every zone
+	MaxCpu *int `json:"max_cpu,omitempty"`
+
+	// MaxRam Maximum GiB of RAM over every zone
+	MaxRam *int `json:"max_ram,omitempty"`
+
+	// MaxReplicas Maximum number of instances over every zone
+	MaxReplicas *int `json:"max_replicas,omitempty"`
+
+	// Tenant Tenant the quota applies to, every tenant when unset
+	Tenant *string `json:"tenant,omitempty"`
+
+	// Tier Tier the quota applies to, every tier when unset or 0
+	Tier  *int        `json:"tier,omitempty"`
+	Usage *QuotaUsage `json:"usage,omitempty"`
+}
+
+// QuotaList defines model for QuotaList.
+type QuotaList struct {
+	Quotas []Quota `json:"quotas"`
+}
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	Applications int `json:"applications"`
+	Cpu          int `json:"cpu"`
+	Ram          int `json:"ram"`
+	Replicas     int `json:"replicas"`
+}
+
+// ScaleRequest defines model for ScaleRequest.
+type ScaleRequest struct {
+	// Replicas Replicas to run in every zone, replacing the replicas set per zone
+	Replicas *int `json:"replicas,omitempty"`
+
+	// Zones Replicas to run in some zones of the application, overriding replicas
+	Zones *map[string]int `json:"zones,omitempty"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	// CreatedAt Time the subscription was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventTypes Lifecycle events to deliver, every event when empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Id ID of the subscription
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Secret Key of the HMAC-SHA256 signature sent in the X-DCM-Signature header. Generated when not set, only returned when the subscription is created
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Endpoint receiving the notifications with POST requests
+	Url string `json:"url"`
+}
+
+// SubscriptionList defines model for SubscriptionList.
+type SubscriptionList struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// Tier A placement tier and the policy evaluated for its applications
+type Tier struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Default Whether applications without a tier are placed in this tier. Replaces the previous default.
+	Default *bool `json:"default,omitempty"`
+
+	// Description Description of the tier
+	Description *string `json:"description,omitempty"`
+
+	// Id Number of the tier, set as the tier of the applications
+	Id int `json:"id"`
+
+	// PolicyPath Path of the policy under the OPA data API
+	PolicyPath string `json:"policy_path"`
+
+	// Services Services allowed in the tier, every service when unset
+	Services *[]string `json:"services,omitempty"`
+}
+
+// TierList defines model for TierList.
+type TierList struct {
+	Tiers []Tier `json:"tiers"`
+}
+
+// WatchEvent defines model for WatchEvent.
+type WatchEvent struct {
+	Application ApplicationResponse `json:"application"`
+
+	// ResourceVersion Version of the change, increasing with every change
+	ResourceVersion int64 `json:"resource_version"`
+
+	// Type Type of the change
+	Type string `json:"type"`
+}
+
+// Zone A namespace of a provider applications can be placed in
+type Zone struct {
+	// Cordoned Whether the zone is cordoned and receives no new application
+	Cordoned  bool       `json:"cordoned"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Environment Value of the environment label of the namespace
+	Environment *string `json:"environment,omitempty"`
+
+	// Healthy Whether the provider listed the zone at the last synchronization
+	Healthy bool `json:"healthy"`
+
+	// Id Name of the namespace
+	Id string `json:"id"`
+
+	// Labels Labels of the namespace
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// LastSeenAt Last synchronization listing the zone
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+
+	// Provider Name of the provider listing the namespace
+	Provider string `json:"provider"`
+
+	// Region Region of the zone, from the region labels of the namespace or its name
+	Region *string `json:"region,omitempty"`
+}
+
+// ZoneDeployment defines model for ZoneDeployment.
+type ZoneDeployment struct {
+	// DeploymentId ID of the deployment in the provider
+	DeploymentId *string `json:"deployment_id,omitempty"`
+
+	// DesiredReplicas Number of replicas the deployment is scaled to (for containers)
+	DesiredReplicas *int `json:"desired_replicas,omitempty"`
+
+	// Message Human-readable status of the deployment
+	Message *string `json:"message,omitempty"`
+
+	// Phase Phase reported by the provider
+	Phase *string `json:"phase,omitempty"`
+
+	// Provider Name of the provider serving the zone
+	Provider *string `json:"provider,omitempty"`
+
+	// ReadyReplicas Number of ready replicas (for containers)
+	ReadyReplicas *int `json:"ready_replicas,omitempty"`
+
+	// Zone Zone of the deployment
+	Zone string `json:"zone"`
+}
+
+// ZoneDrain The evacuation of the applications of a zone
+type ZoneDrain struct {
+	Applications []ZoneDrainApplication `json:"applications"`
+	CompletedAt  *time.Time             `json:"completed_at,omitempty"`
+	CreatedAt    *time.Time             `json:"created_at,omitempty"`
+	Evacuated    int                    `json:"evacuated"`
+	Failed       int                    `json:"failed"`
+	Id           openapi_types.UUID     `json:"id"`
+	Status       ZoneDrainStatus        `json:"status"`
+
+	// Total Number of applications placed in the zone when the drain started
+	Total int    `json:"total"`
+	Zone  string `json:"zone"`
+}
+
+// ZoneDrainStatus defines model for ZoneDrain.Status.
+type ZoneDrainStatus string
+
+// ZoneDrainApplication defines model for ZoneDrainApplication.
+type ZoneDrainApplication struct {
+	ApplicationId openapi_types.UUID `json:"application_id"`
+
+	// Error Why the application could not be evacuated
+	Error  *string                    `json:"error,omitempty"`
+	Name   string                     `json:"name"`
+	Status ZoneDrainApplicationStatus `json:"status"`
+
+	// Zones Zones the application was moved to
+	Zones *[]string `json:"zones,omitempty"`
+}
+
+// ZoneDrainApplicationStatus defines model for ZoneDrainApplication.Status.
+type ZoneDrainApplicationStatus string
+
+// ZoneList defines model for ZoneList.
+type ZoneList struct {
+	Zones []Zone `json:"zones"`
+}
+
+// ZoneSelection defines model for ZoneSelection.
+type ZoneSelection struct {
+	// Reason Why the zone was selected or not
+	Reason string `json:"reason"`
+
+	// Region Region of the zone, spread constraints avoid reusing regions
+	Region *string `json:"region,omitempty"`
+
+	// Score Score given by the policy, higher is preferred
+	Score float64 `json:"score"`
+
+	// Selected Whether the application is deployed in the zone
+	Selected bool   `json:"selected"`
+	Zone     string `json:"zone"`
+}
+
 // ListApplicationsParams defines parameters for ListApplications.
 type ListApplicationsParams struct {
+	// LabelSelector Comma separated labels the applications must have, as key=value or key to only require the key
+	LabelSelector *string `form:"label_selector,omitempty" json:"label_selector,omitempty"`
+
 	// MaxPageSize Maximum number of items to return
 	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 
@@ -97,7 +728,94 @@ type ListApplicationsParams struct {
 type CreateApplicationParams struct {
 	// Id Optional ID for the application
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// WaitForReady Wait until the deployments of every zone are running before returning
+	WaitForReady *bool `form:"wait_for_ready,omitempty" json:"wait_for_ready,omitempty"`
+
+	// ReadyTimeout Maximum number of seconds to wait for the deployments to become ready
+	ReadyTimeout *int `form:"ready_timeout,omitempty" json:"ready_timeout,omitempty"`
+
+	// RollbackOnFailure Delete the application when its deployments fail or are not ready in time, otherwise keep it marked as failed
+	RollbackOnFailure *bool `form:"rollback_on_failure,omitempty" json:"rollback_on_failure,omitempty"`
+}
+
+// ListApplicationEventsParams defines parameters for ListApplicationEvents.
+type ListApplicationEventsParams struct {
+	// Type Only return events of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// MaxPageSize Maximum number of items to return
+	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// PageToken Token for pagination
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// WatchApplicationsParams defines parameters for WatchApplications.
+type WatchApplicationsParams struct {
+	// ResourceVersion Resume after this resource version, usually the ID of the last event received
+	ResourceVersion *int64 `form:"resource_version,omitempty" json:"resource_version,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// ApplicationId Only return events of this application
+	ApplicationId *openapi_types.UUID `form:"application_id,omitempty" json:"application_id,omitempty"`
+
+	// Type Only return events of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Actor Only return events triggered by this actor
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// RequestId Only return events recorded while serving this request
+	RequestId *string `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// Since Only return events recorded at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return events recorded before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// MaxPageSize Maximum number of items to return
+	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// PageToken Token for pagination
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
 // CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
 type CreateApplicationJSONRequestBody = Application
+
+// ReplaceApplicationJSONRequestBody defines body for ReplaceApplication for application/json ContentType.
+type ReplaceApplicationJSONRequestBody = Application
+
+// FailoverApplicationJSONRequestBody defines body for FailoverApplication for application/json ContentType.
+type FailoverApplicationJSONRequestBody = FailoverRequest
+
+// ScaleApplicationJSONRequestBody defines body for ScaleApplication for application/json ContentType.
+type ScaleApplicationJSONRequestBody = ScaleRequest
+
+// BatchCreateApplicationsJSONRequestBody defines body for BatchCreateApplications for application/json ContentType.
+type BatchCreateApplicationsJSONRequestBody = BatchCreateRequest
+
+// BatchDeleteApplicationsJSONRequestBody defines body for BatchDeleteApplications for application/json ContentType.
+type BatchDeleteApplicationsJSONRequestBody = BatchDeleteRequest
+
+// PreviewApplicationJSONRequestBody defines body for PreviewApplication for application/json ContentType.
+type PreviewApplicationJSONRequestBody = Application
+
+// SimulatePoliciesJSONRequestBody defines body for SimulatePolicies for application/json ContentType.
+type SimulatePoliciesJSONRequestBody = PolicySimulationRequest
+
+// CreateQuotaJSONRequestBody defines body for CreateQuota for application/json ContentType.
+type CreateQuotaJSONRequestBody = Quota
+
+// UpdateQuotaJSONRequestBody defines body for UpdateQuota for application/json ContentType.
+type UpdateQuotaJSONRequestBody = Quota
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = Subscription
+
+// CreateTierJSONRequestBody defines body for CreateTier for application/json ContentType.
+type CreateTierJSONRequestBody = Tier
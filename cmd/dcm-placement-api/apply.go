@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	api "github.com/dcm-project/dcm-placement-api/api/v1alpha1"
+	"github.com/dcm-project/dcm-placement-api/internal/api/client"
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/manifest"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var applyOpts struct {
+	filename string
+	prune    bool
+	dryRun   bool
+}
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f apps.yaml",
+	Short: "Converge the applications of a running placement API to a YAML manifest",
+	Long: `Compare the applications of a manifest with the ones of a running placement API, then
+create, update or delete applications through the API to converge. Updated applications are
+redeployed under the same ID, their former deployments being deleted once the new ones are
+created. Applications missing from the manifest are only deleted with --prune.
+The server defaults to $DCM_SERVER_URL and the bearer token to $DCM_TOKEN.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, err := manifest.Load(applyOpts.filename)
+		if err != nil {
+			return err
+		}
+
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		listed, err := listAllApplications(cmd.Context(), c, 100, "")
+		if err != nil {
+			return err
+		}
+		apps := make(model.ApplicationList, 0, len(listed))
+		for _, app := range listed {
+			apps = append(apps, applicationFromAPI(app))
+		}
+		changes, err := manifest.Plan(m, apps, applyOpts.prune)
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		printPlan(out, changes)
+		if applyOpts.dryRun || !hasChanges(changes) {
+			return nil
+		}
+
+		fmt.Fprintln(out)
+		return manifest.Apply(cmd.Context(), apiPlacer{c}, changes, func(change manifest.Change, err error) {
+			if change.Action == manifest.ActionUnchanged {
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(out, "%s %s: failed: %v\n", change.Action, change.Name(), err)
+				return
+			}
+			fmt.Fprintf(out, "%s %s: done\n", change.Action, change.Name())
+		})
+	},
+}
+
+func init() {
+	addServerFlags(applyCmd)
+	applyCmd.Flags().StringVarP(&applyOpts.filename, "filename", "f", "", "Manifest to apply, '-' reads the standard input")
+	applyCmd.Flags().BoolVar(&applyOpts.prune, "prune", false, "Delete the applications missing from the manifest")
+	applyCmd.Flags().BoolVar(&applyOpts.dryRun, "dry-run", false, "Only print the plan")
+	_ = applyCmd.MarkFlagRequired("filename")
+}
+
+func printPlan(out io.Writer, changes []manifest.Change) {
+	counts := map[manifest.Action]int{}
+	for _, change := range changes {
+		counts[change.Action]++
+		switch change.Action {
+		case manifest.ActionCreate:
+			fmt.Fprintf(out, "+ create %s (%s, tier %d)\n", change.Desired.Name, change.Desired.Service, change.Desired.EffectiveTier())
+		case manifest.ActionUpdate:
+			fmt.Fprintf(out, "~ update %s (%s): %s\n", change.Desired.Name, change.Current.ID, strings.Join(change.Diff, ", "))
+		case manifest.ActionDelete:
+			fmt.Fprintf(out, "- delete %s (%s)\n", change.Current.Name, change.Current.ID)
+		case manifest.ActionUnchanged:
+			fmt.Fprintf(out, "  unchanged %s (%s)\n", change.Current.Name, change.Current.ID)
+		}
+	}
+	fmt.Fprintf(out, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts[manifest.ActionCreate], counts[manifest.ActionUpdate], counts[manifest.ActionDelete], counts[manifest.ActionUnchanged])
+}
+
+func hasChanges(changes []manifest.Change) bool {
+	for _, change := range changes {
+		if change.Action != manifest.ActionUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// apiPlacer converges the applications through the placement API, so the changes are
+// serialized with the ones of the server and checked against its quotas.
+type apiPlacer struct {
+	c *client.ClientWithResponses
+}
+
+func (p apiPlacer) CreateApplication(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string, _ service.CreateOptions) (*server.ApplicationResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	params := &api.CreateApplicationParams{}
+	if appID != "" {
+		params.Id = &appID
+	}
+	resp, err := p.c.CreateApplicationWithBodyWithResponse(ctx, params, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(resp.Status(), resp.JSON400, resp.JSON403, resp.JSON500)
+	}
+	return serverApplication(*resp.JSON201)
+}
+
+func (p apiPlacer) ReplaceApplication(ctx context.Context, id uuid.UUID, request *server.CreateApplicationJSONRequestBody) (*server.ApplicationResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.c.ReplaceApplicationWithBodyWithResponse(ctx, id, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.Status(), resp.JSON400, resp.JSON403, resp.JSON404, resp.JSON500)
+	}
+	return serverApplication(*resp.JSON200)
+}
+
+func (p apiPlacer) ScaleApplication(ctx context.Context, id uuid.UUID, opts service.ScaleOptions) (*server.ApplicationResponse, error) {
+	request := api.ScaleRequest{}
+	if opts.Replicas > 0 {
+		request.Replicas = &opts.Replicas
+	}
+	if len(opts.ZoneReplicas) > 0 {
+		request.Zones = &opts.ZoneReplicas
+	}
+	resp, err := p.c.ScaleApplicationWithResponse(ctx, id, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.Status(), resp.JSON400, resp.JSON403, resp.JSON404, resp.JSON500)
+	}
+	return serverApplication(*resp.JSON200)
+}
+
+func (p apiPlacer) DeleteApplication(ctx context.Context, id uuid.UUID) (*server.ApplicationResponse, error) {
+	// The API answers 204, so the raw response is checked
+	resp, err := p.c.DeleteApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("deleting application %s: %s", id, resp.Status)
+	}
+	return &server.ApplicationResponse{Id: &id}, nil
+}
+
+// serverApplication converts an application returned by the client to the type of the server
+// used by the manifests, the two being generated from the same schema.
+func serverApplication(app api.ApplicationResponse) (*server.ApplicationResponse, error) {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+	var result server.ApplicationResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// applicationFromAPI returns the fields of app compared by the manifests.
+func applicationFromAPI(app api.ApplicationResponse) model.Application {
+	result := model.Application{
+		Name:         valueOf(app.Name),
+		Service:      valueOf(app.Service),
+		Tier:         valueOf(app.Tier),
+		Tenant:       valueOf(app.Tenant),
+		Zones:        valueOf(app.Zones),
+		Labels:       valueOf(app.Labels),
+		Annotations:  valueOf(app.Annotations),
+		Affinity:     selectorFromAPI(app.Affinity),
+		AntiAffinity: selectorFromAPI(app.AntiAffinity),
+		Replicas:     valueOf(app.Replicas),
+		ZoneReplicas: valueOf(app.ZoneReplicas),
+	}
+	if app.Id != nil {
+		result.ID = *app.Id
+	}
+	return result
+}
+
+func selectorFromAPI(selector *api.ApplicationSelector) *model.ApplicationSelector {
+	if selector == nil {
+		return nil
+	}
+	return &model.ApplicationSelector{ApplicationIDs: valueOf(selector.ApplicationIds), MatchLabels: valueOf(selector.MatchLabels)}
+}
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/dcm-project/dcm-placement-api/api/v1alpha1"
+	"github.com/dcm-project/dcm-placement-api/internal/api/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// clientOpts are the flags of the commands calling a running placement API.
+var clientOpts struct {
+	server string
+	token  string
+	output string
+}
+
+var appsCmd = &cobra.Command{
+	Use:   "apps",
+	Short: "Manage the applications of a running placement API",
+	Long: `Create, list, inspect, delete and preview applications through the HTTP API.
+The server defaults to $DCM_SERVER_URL and the bearer token to $DCM_TOKEN.`,
+	PersistentPreRunE: validateOutput,
+}
+
+var appsCreateOpts struct {
+	id      string
+	wait    bool
+	timeout time.Duration
+}
+
+var appsCreateCmd = &cobra.Command{
+	Use:          "create",
+	Short:        "Create an application",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		params := &api.CreateApplicationParams{}
+		if appsCreateOpts.id != "" {
+			params.Id = &appsCreateOpts.id
+		}
+		if appsCreateOpts.wait {
+			seconds := int(appsCreateOpts.timeout.Seconds())
+			params.WaitForReady = &appsCreateOpts.wait
+			params.ReadyTimeout = &seconds
+		}
+		resp, err := c.CreateApplicationWithResponse(cmd.Context(), params, applicationFromFlags(cmd))
+		if err != nil {
+			return err
+		}
+		if resp.JSON201 == nil {
+			return responseError(resp.Status(), resp.JSON400, resp.JSON403, resp.JSON500)
+		}
+		return printApplications(cmd.OutOrStdout(), *resp.JSON201)
+	},
+}
+
+var appsListOpts struct {
+	pageSize int
+	selector string
+}
+
+var appsListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "List the applications, following every page",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		apps, err := listAllApplications(cmd.Context(), c, appsListOpts.pageSize, appsListOpts.selector)
+		if err != nil {
+			return err
+		}
+		return printApplications(cmd.OutOrStdout(), apps...)
+	},
+}
+
+var appsGetCmd = &cobra.Command{
+	Use:          "get ID",
+	Short:        "Show an application",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid application ID %q: %w", args[0], err)
+		}
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		resp, err := c.GetApplicationWithResponse(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError(resp.Status(), resp.JSON404, resp.JSON500)
+		}
+		return printApplications(cmd.OutOrStdout(), *resp.JSON200)
+	},
+}
+
+var appsDeleteCmd = &cobra.Command{
+	Use:          "delete ID...",
+	Short:        "Delete applications",
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		for _, arg := range args {
+			id, err := uuid.Parse(arg)
+			if err != nil {
+				return fmt.Errorf("invalid application ID %q: %w", arg, err)
+			}
+			// The API answers 204 with no body, so the raw response is checked
+			resp, err := c.DeleteApplication(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			if resp.StatusCode >= 300 {
+				return fmt.Errorf("deleting application %s: %s", id, resp.Status)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "application %s deleted\n", id)
+		}
+		return nil
+	},
+}
+
+var appsPreviewCmd = &cobra.Command{
+	Use:          "preview",
+	Short:        "Show where an application would be placed without creating it",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		resp, err := c.PreviewApplicationWithResponse(cmd.Context(), applicationFromFlags(cmd))
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError(resp.Status(), resp.JSON400, resp.JSON500)
+		}
+		return printPreview(cmd.OutOrStdout(), *resp.JSON200)
+	},
+}
+
+func init() {
+	addClientFlags(appsCmd)
+
+	for _, cmd := range []*cobra.Command{appsCreateCmd, appsPreviewCmd} {
+		cmd.Flags().String("name", "", "Name of the application")
+		cmd.Flags().String("service", string(api.Container), "Service of the application: webserver or container")
+		cmd.Flags().Int("tier", 0, "Policy tier of the application, the API default when unset")
+		cmd.Flags().StringSlice("zones", nil, "Zones of the application, chosen by the policy when unset")
+		cmd.Flags().String("tenant", "", "Tenant owning the application, the API default when unset")
+		cmd.Flags().StringToString("label", nil, "Labels of the application as key=value, repeatable")
+		cmd.Flags().StringToString("annotation", nil, "Annotations of the application as key=value, repeatable")
+		_ = cmd.MarkFlagRequired("name")
+	}
+	appsCreateCmd.Flags().StringVar(&appsCreateOpts.id, "id", "", "ID of the application, generated when unset")
+	appsCreateCmd.Flags().BoolVar(&appsCreateOpts.wait, "wait", false, "Wait until the deployments of every zone are running")
+	appsCreateCmd.Flags().DurationVar(&appsCreateOpts.timeout, "timeout", 5*time.Minute, "Maximum time to wait with --wait")
+	appsListCmd.Flags().IntVar(&appsListOpts.pageSize, "page-size", 100, "Number of applications requested per page")
+	appsListCmd.Flags().StringVarP(&appsListOpts.selector, "selector", "l", "", "Only list the applications matching the labels, as key=value,key")
+
+	appsCmd.AddCommand(appsCreateCmd, appsListCmd, appsGetCmd, appsDeleteCmd, appsPreviewCmd)
+}
+
+// addClientFlags registers the flags of clientOpts on cmd and its subcommands.
+func addClientFlags(cmd *cobra.Command) {
+	addServerFlags(cmd)
+	cmd.PersistentFlags().StringVarP(&clientOpts.output, "output", "o", outputTable, "Output format: table, json or yaml")
+}
+
+// addServerFlags registers the flags locating the placement API on cmd and its subcommands.
+func addServerFlags(cmd *cobra.Command) {
+	serverURL := os.Getenv("DCM_SERVER_URL")
+	if serverURL == "" {
+		serverURL = "http://localhost:8080"
+	}
+	cmd.PersistentFlags().StringVar(&clientOpts.server, "server", serverURL, "URL of the placement API")
+	cmd.PersistentFlags().StringVar(&clientOpts.token, "token", os.Getenv("DCM_TOKEN"), "Bearer token sent to the placement API")
+}
+
+func validateOutput(cmd *cobra.Command, args []string) error {
+	switch clientOpts.output {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q, expected table, json or yaml", clientOpts.output)
+}
+
+// newAPIClient builds a client of the configured server sending the bearer token, if any.
+func newAPIClient() (*client.ClientWithResponses, error) {
+	return client.NewClientWithResponses(clientOpts.server, client.WithRequestEditorFn(
+		func(ctx context.Context, req *http.Request) error {
+			if clientOpts.token != "" {
+				req.Header.Set("Authorization", "Bearer "+clientOpts.token)
+			}
+			return nil
+		}))
+}
+
+func applicationFromFlags(cmd *cobra.Command) api.Application {
+	name, _ := cmd.Flags().GetString("name")
+	service, _ := cmd.Flags().GetString("service")
+	app := api.Application{Name: name, Service: api.ApplicationService(service)}
+	if cmd.Flags().Changed("tier") {
+		tier, _ := cmd.Flags().GetInt("tier")
+		app.Tier = &tier
+	}
+	if zones, _ := cmd.Flags().GetStringSlice("zones"); len(zones) > 0 {
+		app.Zones = &zones
+	}
+	if tenant, _ := cmd.Flags().GetString("tenant"); tenant != "" {
+		app.Tenant = &tenant
+	}
+	if labels, _ := cmd.Flags().GetStringToString("label"); len(labels) > 0 {
+		app.Labels = &labels
+	}
+	if annotations, _ := cmd.Flags().GetStringToString("annotation"); len(annotations) > 0 {
+		app.Annotations = &annotations
+	}
+	return app
+}
+
+// listAllApplications requests pages until the server stops returning a next page token.
+// An empty selector lists every application.
+func listAllApplications(ctx context.Context, c *client.ClientWithResponses, pageSize int, selector string) ([]api.ApplicationResponse, error) {
+	apps := []api.ApplicationResponse{}
+	params := &api.ListApplicationsParams{MaxPageSize: &pageSize}
+	if selector != "" {
+		params.LabelSelector = &selector
+	}
+	for {
+		resp, err := c.ListApplicationsWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, responseError(resp.Status(), resp.JSON400, resp.JSON500)
+		}
+		apps = append(apps, resp.JSON200.Applications...)
+		next := resp.JSON200.NextPageToken
+		if next == nil || *next == "" {
+			return apps, nil
+		}
+		params.PageToken = next
+	}
+}
+
+// responseError reports the error returned by the API, or the status when there is none.
+func responseError(status string, errs ...*api.Error) error {
+	for _, e := range errs {
+		if e != nil {
+			return fmt.Errorf("%s: %s", status, e.Error)
+		}
+	}
+	return fmt.Errorf("unexpected response: %s", status)
+}
+
+func printApplications(out io.Writer, apps ...api.ApplicationResponse) error {
+	if clientOpts.output != outputTable {
+		if len(apps) == 1 {
+			return printStructured(out, apps[0])
+		}
+		return printStructured(out, apps)
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tTENANT\tSERVICE\tTIER\tZONES\tSTATUS")
+	for _, app := range apps {
+		tier := ""
+		if app.Tier != nil {
+			tier = fmt.Sprint(*app.Tier)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", valueOf(app.Id), valueOf(app.Name), valueOf(app.Tenant), valueOf(app.Service),
+			tier, strings.Join(valueOf(app.Zones), ","), valueOf(app.Status))
+	}
+	return w.Flush()
+}
+
+func printPreview(out io.Writer, preview api.PlacementPreview) error {
+	if clientOpts.output != outputTable {
+		return printStructured(out, preview)
+	}
+	if !preview.Allowed {
+		fmt.Fprintf(out, "Rejected by the policy (tier %d): %s\n", preview.Tier, valueOf(preview.Reason))
+		return nil
+	}
+	fmt.Fprintf(out, "Allowed by the policy (tier %d)\n", preview.Tier)
+	providers := map[string]string{}
+	for _, d := range valueOf(preview.Deployments) {
+		providers[d.Zone] = valueOf(d.Provider)
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ZONE\tSELECTED\tPROVIDER\tREASON")
+	for _, z := range valueOf(preview.Placement) {
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", z.Zone, z.Selected, providers[z.Zone], z.Reason)
+	}
+	return w.Flush()
+}
+
+// printStructured writes v as JSON or YAML. YAML goes through JSON so both formats
+// use the field names of the API.
+func printStructured(out io.Writer, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if clientOpts.output == outputJSON {
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func valueOf[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dcm-project/dcm-placement-api/internal/config"
+	"github.com/dcm-project/dcm-placement-api/internal/manifest"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var exportOpts struct {
+	output string
+}
+
+var exportCmd = &cobra.Command{
+	Use:          "export",
+	Short:        "Dump the stored applications as a YAML manifest",
+	Long:         `Dump the stored applications in the manifest format read by apply.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, s, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+
+		apps, err := listApplications(cmd.Context(), s)
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = cmd.OutOrStdout()
+		if exportOpts.output != "" && exportOpts.output != "-" {
+			f, err := os.Create(exportOpts.output)
+			if err != nil {
+				return fmt.Errorf("creating %s: %w", exportOpts.output, err)
+			}
+			defer f.Close()
+			out = f
+		}
+
+		encoder := yaml.NewEncoder(out)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(manifest.Export(apps)); err != nil {
+			return err
+		}
+		return encoder.Close()
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportOpts.output, "output", "o", "", "File to write, the standard output by default")
+}
+
+// openStore connects to the database configured by the environment.
+func openStore() (*config.Config, store.Store, error) {
+	cfg, err := config.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading configuration: %w", err)
+	}
+	db, err := store.InitDB(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("initializing data store: %w", err)
+	}
+	return cfg, store.NewStore(db), nil
+}
+
+// listApplications returns every stored application, following the pages.
+func listApplications(ctx context.Context, s store.Store) (model.ApplicationList, error) {
+	var apps model.ApplicationList
+	var pageToken *string
+	for {
+		page, next, err := s.Application().List(ctx, store.ApplicationFilter{}, nil, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("listing applications: %w", err)
+		}
+		apps = append(apps, page...)
+		if next == nil {
+			return apps, nil
+		}
+		pageToken = next
+	}
+}
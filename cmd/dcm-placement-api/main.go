@@ -20,52 +20,81 @@ func main() {
 	zap.ReplaceGlobals(logger)
 	defer logger.Sync()
 
-	err := runCmd.Execute()
+	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(1)
 	}
 }
 
+var rootCmd = &cobra.Command{
+	Use:   "dcm-placement-api",
+	Short: "DCM Placement API",
+	// Running the API is the default so existing deployments keep working
+	RunE: runAPI,
+}
+
 var runCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Run the planner api",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		defer zap.S().Info("API service stopped")
+	RunE:  runAPI,
+}
 
-		cfg, err := config.New()
-		if err != nil {
-			zap.S().Fatalw("reading configuration", "error", err)
-		}
+func runAPI(cmd *cobra.Command, args []string) error {
+	defer zap.S().Info("API service stopped")
+
+	cfg, err := config.New()
+	if err != nil {
+		zap.S().Fatalw("reading configuration", "error", err)
+	}
+	if cmd.Flags().Changed("mode") {
+		cfg.Service.Mode = mode
+	}
+	if cfg.Service.Mode != config.ModeProd && cfg.Service.Mode != config.ModeDev {
+		zap.S().Fatalw("invalid run mode", "mode", cfg.Service.Mode)
+	}
+	if cfg.Service.Mode == config.ModeDev {
+		// Keep everything in memory so the service runs without external dependencies
+		cfg.Database.Type = "sqlite"
+		cfg.Database.Name = "file::memory:?cache=shared"
+	}
+
+	zap.S().Info("Starting API service...")
+	zap.S().Info("Initializing data store")
+	db, err := store.InitDB(cfg)
+	if err != nil {
+		zap.S().Fatalw("initializing data store", "error", err)
+	}
+
+	store := store.NewStore(db)
+	defer store.Close()
 
-		zap.S().Info("Starting API service...")
-		zap.S().Info("Initializing data store")
-		db, err := store.InitDB(cfg)
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
+
+	go func() {
+		defer cancel()
+		listener, err := newListener(cfg.Service.Address)
 		if err != nil {
-			zap.S().Fatalw("initializing data store", "error", err)
+			zap.S().Fatalw("creating listener", "error", err)
 		}
 
-		store := store.NewStore(db)
-		defer store.Close()
-
-		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
+		server := apiserver.New(cfg, store, listener)
+		if err := server.Run(ctx); err != nil {
+			zap.S().Fatalw("Error running server", "error", err)
+		}
+	}()
 
-		go func() {
-			defer cancel()
-			listener, err := newListener(cfg.Service.Address)
-			if err != nil {
-				zap.S().Fatalw("creating listener", "error", err)
-			}
+	<-ctx.Done()
 
-			server := apiserver.New(cfg, store, listener)
-			if err := server.Run(ctx); err != nil {
-				zap.S().Fatalw("Error running server", "error", err)
-			}
-		}()
+	return nil
+}
 
-		<-ctx.Done()
+var mode string
 
-		return nil
-	},
+func init() {
+	for _, cmd := range []*cobra.Command{rootCmd, runCmd} {
+		cmd.Flags().StringVar(&mode, "mode", config.ModeProd, "Run mode: 'prod' or 'dev' (in-memory fakes for OPA, providers and database)")
+	}
+	rootCmd.AddCommand(runCmd, applyCmd, exportCmd, appsCmd, policyCmd)
 }
 
 func newListener(address string) (net.Listener, error) {
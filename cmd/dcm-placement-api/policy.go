@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	api "github.com/dcm-project/dcm-placement-api/api/v1alpha1"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/spf13/cobra"
+)
+
+var policyCmd = &cobra.Command{
+	Use:               "policy",
+	Short:             "Work with the placement policies of a running placement API",
+	PersistentPreRunE: validateOutput,
+}
+
+var policySimulateOpts struct {
+	bundle string
+}
+
+var policySimulateCmd = &cobra.Command{
+	Use:   "simulate --bundle ./policies",
+	Short: "Show how a candidate policy bundle would place the stored applications",
+	Long: `Send the rego files and data.json files of a bundle directory to the placement API, which
+evaluates every stored application against them and reports which applications would keep
+their zones, move or be rejected. Nothing is changed.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bundle, err := opa.LoadBundle(policySimulateOpts.bundle)
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		body := api.PolicySimulationRequest{Modules: bundle.Modules}
+		if len(bundle.Data) > 0 {
+			body.Data = &bundle.Data
+		}
+		resp, err := c.SimulatePoliciesWithResponse(cmd.Context(), body)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError(resp.Status(), resp.JSON400, resp.JSON500)
+		}
+		return printSimulation(cmd.OutOrStdout(), *resp.JSON200)
+	},
+}
+
+func init() {
+	addClientFlags(policyCmd)
+	policySimulateCmd.Flags().StringVar(&policySimulateOpts.bundle, "bundle", "", "Directory of the candidate policy bundle")
+	_ = policySimulateCmd.MarkFlagRequired("bundle")
+	policyCmd.AddCommand(policySimulateCmd)
+}
+
+func printSimulation(out io.Writer, simulation api.PolicySimulation) error {
+	if clientOpts.output != outputTable {
+		return printStructured(out, simulation)
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tTIER\tOUTCOME\tCURRENT\tSIMULATED\tREASON")
+	for _, app := range simulation.Applications {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n", app.Id, app.Name, app.Tier, app.Outcome,
+			strings.Join(app.CurrentZones, ","), strings.Join(valueOf(app.SimulatedZones), ","), valueOf(app.Reason))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%d unchanged, %d moved, %d invalid, %d errors\n",
+		simulation.Unchanged, simulation.Moved, simulation.Invalid, simulation.Errors)
+	return err
+}
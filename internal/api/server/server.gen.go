@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -15,14 +17,85 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ApplicationResponseEnvironment.
+const (
+	ApplicationResponseEnvironmentBackup     ApplicationResponseEnvironment = "backup"
+	ApplicationResponseEnvironmentProduction ApplicationResponseEnvironment = "production"
+)
+
 // Defines values for ApplicationService.
 const (
 	Container ApplicationService = "container"
 	Webserver ApplicationService = "webserver"
 )
 
+// Defines values for ApplicationSimulationOutcome.
+const (
+	ApplicationSimulationOutcomeError     ApplicationSimulationOutcome = "error"
+	ApplicationSimulationOutcomeInvalid   ApplicationSimulationOutcome = "invalid"
+	ApplicationSimulationOutcomeMoved     ApplicationSimulationOutcome = "moved"
+	ApplicationSimulationOutcomeUnchanged ApplicationSimulationOutcome = "unchanged"
+)
+
+// Defines values for BatchCreateRequestMode.
+const (
+	BatchCreateRequestModeAtomic      BatchCreateRequestMode = "atomic"
+	BatchCreateRequestModeIndependent BatchCreateRequestMode = "independent"
+)
+
+// Defines values for BatchDeleteRequestMode.
+const (
+	BatchDeleteRequestModeAtomic      BatchDeleteRequestMode = "atomic"
+	BatchDeleteRequestModeIndependent BatchDeleteRequestMode = "independent"
+)
+
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemResultStatusFailed     BatchItemResultStatus = "failed"
+	BatchItemResultStatusRolledBack BatchItemResultStatus = "rolled_back"
+	BatchItemResultStatusSkipped    BatchItemResultStatus = "skipped"
+	BatchItemResultStatusSucceeded  BatchItemResultStatus = "succeeded"
+)
+
+// Defines values for CircuitBreakerState.
+const (
+	Closed   CircuitBreakerState = "closed"
+	HalfOpen CircuitBreakerState = "half-open"
+	Open     CircuitBreakerState = "open"
+)
+
+// Defines values for FailoverRequestTarget.
+const (
+	FailoverRequestTargetBackup     FailoverRequestTarget = "backup"
+	FailoverRequestTargetProduction FailoverRequestTarget = "production"
+)
+
+// Defines values for ZoneDrainApplicationStatus.
+const (
+	ZoneDrainApplicationStatusEvacuated ZoneDrainApplicationStatus = "evacuated"
+	ZoneDrainApplicationStatusFailed    ZoneDrainApplicationStatus = "failed"
+	ZoneDrainApplicationStatusPending   ZoneDrainApplicationStatus = "pending"
+)
+
+// Defines values for ZoneDrainStatus.
+const (
+	ZoneDrainStatusCompleted ZoneDrainStatus = "completed"
+	ZoneDrainStatusFailed    ZoneDrainStatus = "failed"
+	ZoneDrainStatusRunning   ZoneDrainStatus = "running"
+)
+
 // Application defines model for Application.
 type Application struct {
+	Affinity *ApplicationSelector `json:"affinity,omitempty"`
+
+	// Annotations Annotations of the application, passed to the policies. The dcm.io/ prefix is reserved.
+	Annotations  *map[string]string   `json:"annotations,omitempty"`
+	AntiAffinity *ApplicationSelector `json:"anti_affinity,omitempty"`
+
+	// Labels Labels of the application, set on its deployments and matched by label selectors and the affinity
+	// rules of other applications. The app-id key and the dcm.io/ prefix are reserved.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name of the application
 	Name string `json:"name"`
 
@@ -32,7 +105,10 @@ type Application struct {
 	// Service Service of the application
 	Service ApplicationService `json:"service"`
 
-	// Tier Policy Tier of the application
+	// Tenant Tenant owning the application, whose quotas it counts against
+	Tenant *string `json:"tenant,omitempty"`
+
+	// Tier Policy Tier of the application, registered in /tiers. The default tier when unset.
 	Tier *int `json:"tier,omitempty"`
 
 	// Zones Zones of the application
@@ -42,6 +118,30 @@ type Application struct {
 // ApplicationService Service of the application
 type ApplicationService string
 
+// ApplicationFailover defines model for ApplicationFailover.
+type ApplicationFailover struct {
+	// At When the application was failed over
+	At time.Time `json:"at"`
+
+	// Automatic Whether the failover was triggered because every production deployment failed
+	Automatic bool `json:"automatic"`
+
+	// From Environment the application left
+	From string `json:"from"`
+
+	// FromZones Zones the application left
+	FromZones *[]string `json:"from_zones,omitempty"`
+
+	// Reason Why the application was failed over
+	Reason *string `json:"reason,omitempty"`
+
+	// To Environment the application moved to
+	To string `json:"to"`
+
+	// ToZones Zones the application moved to
+	ToZones []string `json:"to_zones"`
+}
+
 // ApplicationList defines model for ApplicationList.
 type ApplicationList struct {
 	Applications []ApplicationResponse `json:"applications"`
@@ -52,267 +152,1933 @@ type ApplicationList struct {
 
 // ApplicationResponse defines model for ApplicationResponse.
 type ApplicationResponse struct {
+	Affinity *ApplicationSelector `json:"affinity,omitempty"`
+
+	// Annotations Annotations of the application
+	Annotations  *map[string]string   `json:"annotations,omitempty"`
+	AntiAffinity *ApplicationSelector `json:"anti_affinity,omitempty"`
+
+	// Deployments Status of the deployment in each zone
+	Deployments *[]ZoneDeployment `json:"deployments,omitempty"`
+
+	// Environment Environment whose zones host the application, changed by failovers
+	Environment *ApplicationResponseEnvironment `json:"environment,omitempty"`
+
+	// Failovers Failovers of the application, oldest first
+	Failovers *[]ApplicationFailover `json:"failovers,omitempty"`
+
 	// Id ID of the application
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
+	// Labels Labels of the application
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name of the application
 	Name *string `json:"name,omitempty"`
 
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
+	// Placement Candidate zones of the policy, ranked, with the reason each was selected or not
+	Placement *[]ZoneSelection `json:"placement,omitempty"`
+
+	// Replicas Replicas run in each zone, unless overridden in zone_replicas
+	Replicas *int `json:"replicas,omitempty"`
+
 	// Service Service of the application
 	Service *string `json:"service,omitempty"`
 
+	// Status Aggregated status of the application deployments
+	Status *string `json:"status,omitempty"`
+
+	// StatusMessage Details about the status of the application
+	StatusMessage *string `json:"status_message,omitempty"`
+
+	// Tenant Tenant owning the application
+	Tenant *string `json:"tenant,omitempty"`
+
 	// Tier Policy Tier of the application
 	Tier *int `json:"tier,omitempty"`
 
+	// ZoneReplicas Replicas run in the zones scaled individually
+	ZoneReplicas *map[string]int `json:"zone_replicas,omitempty"`
+
 	// Zones Zones of the application
 	Zones *[]string `json:"zones,omitempty"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	// Code Error code
-	Code *int `json:"code,omitempty"`
+// ApplicationResponseEnvironment Environment whose zones host the application, changed by failovers
+type ApplicationResponseEnvironment string
 
-	// Error Error message
-	Error string `json:"error"`
+// ApplicationSelector Applications referenced by an affinity rule, by ID or by labels. With affinity, every zone of the
+// application must host one of them; with anti-affinity, none may.
+type ApplicationSelector struct {
+	// ApplicationIds IDs of the applications
+	ApplicationIds *[]openapi_types.UUID `json:"application_ids,omitempty"`
 
-	// Type Error type
-	Type string `json:"type"`
+	// MatchLabels Labels the applications must all have
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
 }
 
-// Health defines model for Health.
-type Health struct {
-	// Path Canonical path of the resource
-	Path *string `json:"path,omitempty"`
+// ApplicationSimulation defines model for ApplicationSimulation.
+type ApplicationSimulation struct {
+	CurrentZones []string           `json:"current_zones"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
 
-	// Status Health status
-	Status *string `json:"status,omitempty"`
-}
+	// Outcome Effect of the candidate policies on the application
+	Outcome ApplicationSimulationOutcome `json:"outcome"`
 
-// ListApplicationsParams defines parameters for ListApplications.
-type ListApplicationsParams struct {
-	// MaxPageSize Maximum number of items to return
-	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+	// Reason Why the application is invalid or could not be evaluated
+	Reason *string `json:"reason,omitempty"`
 
-	// PageToken Token for pagination
-	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	// SimulatedZones Zones the application would be placed in, unset when invalid
+	SimulatedZones *[]string `json:"simulated_zones,omitempty"`
+	Tenant         *string   `json:"tenant,omitempty"`
+	Tier           int       `json:"tier"`
 }
 
-// CreateApplicationParams defines parameters for CreateApplication.
-type CreateApplicationParams struct {
-	// Id Optional ID for the application
-	Id *string `form:"id,omitempty" json:"id,omitempty"`
-}
+// ApplicationSimulationOutcome Effect of the candidate policies on the application
+type ApplicationSimulationOutcome string
 
-// CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
-type CreateApplicationJSONRequestBody = Application
+// BatchCreateItem defines model for BatchCreateItem.
+type BatchCreateItem struct {
+	Application Application `json:"application"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get all applications
-	// (GET /applications)
-	ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams)
-	// Create an application
-	// (POST /applications)
-	CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams)
-	// Delete an application
-	// (DELETE /applications/{id})
-	DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Health check
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Id Optional ID for the application
+	Id *openapi_types.UUID `json:"id,omitempty"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// BatchCreateRequest defines model for BatchCreateRequest.
+type BatchCreateRequest struct {
+	// Items Applications to create, at most 100
+	Items []BatchCreateItem `json:"items"`
 
-type Unimplemented struct{}
+	// MaxConcurrency Maximum number of items processed in parallel
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
 
-// Get all applications
-// (GET /applications)
-func (_ Unimplemented) ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Mode Process the batch all-or-nothing or every item on its own
+	Mode *BatchCreateRequestMode `json:"mode,omitempty"`
 }
 
-// Create an application
-// (POST /applications)
-func (_ Unimplemented) CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// BatchCreateRequestMode Process the batch all-or-nothing or every item on its own
+type BatchCreateRequestMode string
 
-// Delete an application
-// (DELETE /applications/{id})
-func (_ Unimplemented) DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// BatchDeleteRequest defines model for BatchDeleteRequest.
+type BatchDeleteRequest struct {
+	// Ids IDs of the applications to delete, at most 100
+	Ids []openapi_types.UUID `json:"ids"`
 
-// Health check
-// (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// MaxConcurrency Maximum number of items processed in parallel
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	// Mode Process the batch all-or-nothing or every item on its own
+	Mode *BatchDeleteRequestMode `json:"mode,omitempty"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// BatchDeleteRequestMode Process the batch all-or-nothing or every item on its own
+type BatchDeleteRequestMode string
 
-// ListApplications operation middleware
-func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// BatchItemResult defines model for BatchItemResult.
+type BatchItemResult struct {
+	Application *ApplicationResponse `json:"application,omitempty"`
 
-	var err error
+	// Error Reason the item did not succeed
+	Error *string `json:"error,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListApplicationsParams
+	// Id ID of the application
+	Id *openapi_types.UUID `json:"id,omitempty"`
 
-	// ------------- Optional query parameter "max_page_size" -------------
+	// Index Position of the item in the request
+	Index int `json:"index"`
 
-	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
-		return
-	}
+	// Status Outcome of the item
+	Status BatchItemResultStatus `json:"status"`
+}
 
-	// ------------- Optional query parameter "page_token" -------------
+// BatchItemResultStatus Outcome of the item
+type BatchItemResultStatus string
 
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
-		return
-	}
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// Failed Number of items that did not succeed
+	Failed int `json:"failed"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListApplications(w, r, params)
-	}))
+	// Results Result of each item, in the order of the request
+	Results []BatchItemResult `json:"results"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Succeeded Number of items that succeeded
+	Succeeded int `json:"succeeded"`
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// CircuitBreaker defines model for CircuitBreaker.
+type CircuitBreaker struct {
+	// Failures Number of consecutive failed calls
+	Failures *int `json:"failures,omitempty"`
+
+	// Name Name of the protected dependency
+	Name string `json:"name"`
+
+	// State Current state of the circuit breaker
+	State CircuitBreakerState `json:"state"`
 }
 
-// CreateApplication operation middleware
-func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// CircuitBreakerState Current state of the circuit breaker
+type CircuitBreakerState string
 
-	var err error
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// Attempts Number of delivery attempts
+	Attempts *int `json:"attempts,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateApplicationParams
+	// CreatedAt Time the notification was given up
+	CreatedAt *time.Time `json:"created_at,omitempty"`
 
-	// ------------- Optional query parameter "id" -------------
+	// EventId ID of the undelivered CloudEvent
+	EventId string `json:"event_id"`
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// EventType Type of the undelivered CloudEvent
+	EventType string `json:"event_type"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateApplication(w, r, params)
-	}))
+	// Id Sequence number of the dead letter
+	Id int `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// LastError Error of the last delivery attempt
+	LastError *string `json:"last_error,omitempty"`
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	// Payload Undelivered CloudEvent, as sent to the webhook
+	Payload *string `json:"payload,omitempty"`
 }
 
-// DeleteApplication operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApplication(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	DeadLetters []DeadLetter `json:"dead_letters"`
+}
 
-	var err error
+// Error defines model for Error.
+type Error struct {
+	// Code Error code
+	Code *int `json:"code,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Error Error message
+	Error string `json:"error"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Type Error type
+	Type string `json:"type"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApplication(w, r, id)
-	}))
+// Event defines model for Event.
+type Event struct {
+	// Actor User or system that triggered the event
+	Actor *string `json:"actor,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ApplicationId ID of the application the event relates to
+	ApplicationId *openapi_types.UUID `json:"application_id,omitempty"`
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// CreatedAt Time the event was recorded
+	CreatedAt time.Time `json:"created_at"`
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// DeploymentId Deployment the event relates to
+	DeploymentId *string `json:"deployment_id,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+	// Id Sequence number of the event
+	Id int `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Message Human-readable description of the event
+	Message *string `json:"message,omitempty"`
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// Provider Provider the event relates to
+	Provider *string `json:"provider,omitempty"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// RequestId ID of the API request that triggered the event
+	RequestId *string `json:"request_id,omitempty"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	// Type Type of the event
+	Type string `json:"type"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+	// Zone Zone the event relates to
+	Zone *string `json:"zone,omitempty"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// EventList defines model for EventList.
+type EventList struct {
+	Events []Event `json:"events"`
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	// NextPageToken Token for retrieving the next page of results
+	NextPageToken *string `json:"next_page_token,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// FailoverRequest defines model for FailoverRequest.
+type FailoverRequest struct {
+	// Reason Why the application is failed over, kept in its history
+	Reason *string `json:"reason,omitempty"`
 
-type RequiredParamError struct {
-	ParamName string
+	// Target Environment to move the application to, the one it is not in when unset
+	Target *FailoverRequestTarget `json:"target,omitempty"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// FailoverRequestTarget Environment to move the application to, the one it is not in when unset
+type FailoverRequestTarget string
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// Health defines model for Health.
+type Health struct {
+	// CircuitBreakers State of the circuit breakers protecting outbound dependencies
+	CircuitBreakers *[]CircuitBreaker `json:"circuit_breakers,omitempty"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// Path Canonical path of the resource
+	Path *string `json:"path,omitempty"`
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	// Status Health status
+	Status *string `json:"status,omitempty"`
+}
+
+// PlacementDecision The policy decision that placed an application
+type PlacementDecision struct {
+	ApplicationId openapi_types.UUID `json:"application_id"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+
+	// DecisionId ID of the decision in the OPA decision logs, unset when they are disabled
+	DecisionId *string `json:"decision_id,omitempty"`
+
+	// Explanation Explanation of the evaluation, when enabled with DCM_OPA_EXPLAIN
+	Explanation *[]map[string]interface{} `json:"explanation,omitempty"`
+
+	// Input Input of the policy
+	Input map[string]interface{} `json:"input"`
+
+	// Policy Path of the policy evaluated
+	Policy string `json:"policy"`
+
+	// Result Result of the policy
+	Result map[string]interface{} `json:"result"`
+
+	// Revision Revision of the policy bundles that made the decision, unset when not loaded from bundles
+	Revision *string `json:"revision,omitempty"`
+
+	// Tier Tier of the application
+	Tier int `json:"tier"`
+}
+
+// PlacementPreview defines model for PlacementPreview.
+type PlacementPreview struct {
+	// Allowed Whether the policy allows the application
+	Allowed bool `json:"allowed"`
+
+	// Deployments Provider that would serve each zone
+	Deployments *[]ZoneDeployment `json:"deployments,omitempty"`
+
+	// Placement Candidate zones of the policy, ranked, with the reason each would be selected or not
+	Placement *[]ZoneSelection `json:"placement,omitempty"`
+
+	// Reason Why the policy rejected the application
+	Reason *string `json:"reason,omitempty"`
+
+	// Tier Policy Tier of the application
+	Tier int `json:"tier"`
+
+	// Zones Zones the application would be placed in
+	Zones *[]string `json:"zones,omitempty"`
+}
+
+// PolicySimulation defines model for PolicySimulation.
+type PolicySimulation struct {
+	Applications []ApplicationSimulation `json:"applications"`
+
+	// Errors Number of applications that could not be evaluated
+	Errors int `json:"errors"`
+
+	// Invalid Number of applications rejected by the candidate policies
+	Invalid int `json:"invalid"`
+
+	// Moved Number of applications placed in other zones
+	Moved int `json:"moved"`
+
+	// Unchanged Number of applications keeping their zones
+	Unchanged int `json:"unchanged"`
+}
+
+// PolicySimulationRequest A candidate policy bundle
+type PolicySimulationRequest struct {
+	// Data Data of the bundle, as in its root data.json
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Modules Sources of the rego files of the bundle by file name
+	Modules map[string]string `json:"modules"`
+}
+
+// Quota Limits of the applications of a tenant, of a tier, or of a tier within a tenant. Usage counts
+// the catalog specs of every application once per zone. Unset limits are unlimited.
+type Quota struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// MaxApplications Maximum number of applications
+	MaxApplications *int `json:"max_applications,omitempty"`
+
+	// MaxCpu Maximum number of CPU cores over every zone
+	MaxCpu *int `json:"max_cpu,omitempty"`
+
+	// MaxRam Maximum GiB of RAM over every zone
+	MaxRam *int `json:"max_ram,omitempty"`
+
+	// MaxReplicas Maximum number of instances over every zone
+	MaxReplicas *int `json:"max_replicas,omitempty"`
+
+	// Tenant Tenant the quota applies to, every tenant when unset
+	Tenant *string `json:"tenant,omitempty"`
+
+	// Tier Tier the quota applies to, every tier when unset or 0
+	Tier  *int        `json:"tier,omitempty"`
+	Usage *QuotaUsage `json:"usage,omitempty"`
+}
+
+// QuotaList defines model for QuotaList.
+type QuotaList struct {
+	Quotas []Quota `json:"quotas"`
+}
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	Applications int `json:"applications"`
+	Cpu          int `json:"cpu"`
+	Ram          int `json:"ram"`
+	Replicas     int `json:"replicas"`
+}
+
+// ScaleRequest defines model for ScaleRequest.
+type ScaleRequest struct {
+	// Replicas Replicas to run in every zone, replacing the replicas set per zone
+	Replicas *int `json:"replicas,omitempty"`
+
+	// Zones Replicas to run in some zones of the application, overriding replicas
+	Zones *map[string]int `json:"zones,omitempty"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	// CreatedAt Time the subscription was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventTypes Lifecycle events to deliver, every event when empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Id ID of the subscription
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Secret Key of the HMAC-SHA256 signature sent in the X-DCM-Signature header. Generated when not set, only returned when the subscription is created
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Endpoint receiving the notifications with POST requests
+	Url string `json:"url"`
+}
+
+// SubscriptionList defines model for SubscriptionList.
+type SubscriptionList struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// Tier A placement tier and the policy evaluated for its applications
+type Tier struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Default Whether applications without a tier are placed in this tier. Replaces the previous default.
+	Default *bool `json:"default,omitempty"`
+
+	// Description Description of the tier
+	Description *string `json:"description,omitempty"`
+
+	// Id Number of the tier, set as the tier of the applications
+	Id int `json:"id"`
+
+	// PolicyPath Path of the policy under the OPA data API
+	PolicyPath string `json:"policy_path"`
+
+	// Services Services allowed in the tier, every service when unset
+	Services *[]string `json:"services,omitempty"`
+}
+
+// TierList defines model for TierList.
+type TierList struct {
+	Tiers []Tier `json:"tiers"`
+}
+
+// WatchEvent defines model for WatchEvent.
+type WatchEvent struct {
+	Application ApplicationResponse `json:"application"`
+
+	// ResourceVersion Version of the change, increasing with every change
+	ResourceVersion int64 `json:"resource_version"`
+
+	// Type Type of the change
+	Type string `json:"type"`
+}
+
+// Zone A namespace of a provider applications can be placed in
+type Zone struct {
+	// Cordoned Whether the zone is cordoned and receives no new application
+	Cordoned  bool       `json:"cordoned"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Environment Value of the environment label of the namespace
+	Environment *string `json:"environment,omitempty"`
+
+	// Healthy Whether the provider listed the zone at the last synchronization
+	Healthy bool `json:"healthy"`
+
+	// Id Name of the namespace
+	Id string `json:"id"`
+
+	// Labels Labels of the namespace
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// LastSeenAt Last synchronization listing the zone
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+
+	// Provider Name of the provider listing the namespace
+	Provider string `json:"provider"`
+
+	// Region Region of the zone, from the region labels of the namespace or its name
+	Region *string `json:"region,omitempty"`
+}
+
+// ZoneDeployment defines model for ZoneDeployment.
+type ZoneDeployment struct {
+	// DeploymentId ID of the deployment in the provider
+	DeploymentId *string `json:"deployment_id,omitempty"`
+
+	// DesiredReplicas Number of replicas the deployment is scaled to (for containers)
+	DesiredReplicas *int `json:"desired_replicas,omitempty"`
+
+	// Message Human-readable status of the deployment
+	Message *string `json:"message,omitempty"`
+
+	// Phase Phase reported by the provider
+	Phase *string `json:"phase,omitempty"`
+
+	// Provider Name of the provider serving the zone
+	Provider *string `json:"provider,omitempty"`
+
+	// ReadyReplicas Number of ready replicas (for containers)
+	ReadyReplicas *int `json:"ready_replicas,omitempty"`
+
+	// Zone Zone of the deployment
+	Zone string `json:"zone"`
+}
+
+// ZoneDrain The evacuation of the applications of a zone
+type ZoneDrain struct {
+	Applications []ZoneDrainApplication `json:"applications"`
+	CompletedAt  *time.Time             `json:"completed_at,omitempty"`
+	CreatedAt    *time.Time             `json:"created_at,omitempty"`
+	Evacuated    int                    `json:"evacuated"`
+	Failed       int                    `json:"failed"`
+	Id           openapi_types.UUID     `json:"id"`
+	Status       ZoneDrainStatus        `json:"status"`
+
+	// Total Number of applications placed in the zone when the drain started
+	Total int    `json:"total"`
+	Zone  string `json:"zone"`
+}
+
+// ZoneDrainStatus defines model for ZoneDrain.Status.
+type ZoneDrainStatus string
+
+// ZoneDrainApplication defines model for ZoneDrainApplication.
+type ZoneDrainApplication struct {
+	ApplicationId openapi_types.UUID `json:"application_id"`
+
+	// Error Why the application could not be evacuated
+	Error  *string                    `json:"error,omitempty"`
+	Name   string                     `json:"name"`
+	Status ZoneDrainApplicationStatus `json:"status"`
+
+	// Zones Zones the application was moved to
+	Zones *[]string `json:"zones,omitempty"`
+}
+
+// ZoneDrainApplicationStatus defines model for ZoneDrainApplication.Status.
+type ZoneDrainApplicationStatus string
+
+// ZoneList defines model for ZoneList.
+type ZoneList struct {
+	Zones []Zone `json:"zones"`
+}
+
+// ZoneSelection defines model for ZoneSelection.
+type ZoneSelection struct {
+	// Reason Why the zone was selected or not
+	Reason string `json:"reason"`
+
+	// Region Region of the zone, spread constraints avoid reusing regions
+	Region *string `json:"region,omitempty"`
+
+	// Score Score given by the policy, higher is preferred
+	Score float64 `json:"score"`
+
+	// Selected Whether the application is deployed in the zone
+	Selected bool   `json:"selected"`
+	Zone     string `json:"zone"`
+}
+
+// ListApplicationsParams defines parameters for ListApplications.
+type ListApplicationsParams struct {
+	// LabelSelector Comma separated labels the applications must have, as key=value or key to only require the key
+	LabelSelector *string `form:"label_selector,omitempty" json:"label_selector,omitempty"`
+
+	// MaxPageSize Maximum number of items to return
+	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// PageToken Token for pagination
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// CreateApplicationParams defines parameters for CreateApplication.
+type CreateApplicationParams struct {
+	// Id Optional ID for the application
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// WaitForReady Wait until the deployments of every zone are running before returning
+	WaitForReady *bool `form:"wait_for_ready,omitempty" json:"wait_for_ready,omitempty"`
+
+	// ReadyTimeout Maximum number of seconds to wait for the deployments to become ready
+	ReadyTimeout *int `form:"ready_timeout,omitempty" json:"ready_timeout,omitempty"`
+
+	// RollbackOnFailure Delete the application when its deployments fail or are not ready in time, otherwise keep it marked as failed
+	RollbackOnFailure *bool `form:"rollback_on_failure,omitempty" json:"rollback_on_failure,omitempty"`
+}
+
+// ListApplicationEventsParams defines parameters for ListApplicationEvents.
+type ListApplicationEventsParams struct {
+	// Type Only return events of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// MaxPageSize Maximum number of items to return
+	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// PageToken Token for pagination
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// WatchApplicationsParams defines parameters for WatchApplications.
+type WatchApplicationsParams struct {
+	// ResourceVersion Resume after this resource version, usually the ID of the last event received
+	ResourceVersion *int64 `form:"resource_version,omitempty" json:"resource_version,omitempty"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// ApplicationId Only return events of this application
+	ApplicationId *openapi_types.UUID `form:"application_id,omitempty" json:"application_id,omitempty"`
+
+	// Type Only return events of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Actor Only return events triggered by this actor
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// RequestId Only return events recorded while serving this request
+	RequestId *string `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// Since Only return events recorded at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return events recorded before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// MaxPageSize Maximum number of items to return
+	MaxPageSize *int `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// PageToken Token for pagination
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
+type CreateApplicationJSONRequestBody = Application
+
+// ReplaceApplicationJSONRequestBody defines body for ReplaceApplication for application/json ContentType.
+type ReplaceApplicationJSONRequestBody = Application
+
+// FailoverApplicationJSONRequestBody defines body for FailoverApplication for application/json ContentType.
+type FailoverApplicationJSONRequestBody = FailoverRequest
+
+// ScaleApplicationJSONRequestBody defines body for ScaleApplication for application/json ContentType.
+type ScaleApplicationJSONRequestBody = ScaleRequest
+
+// BatchCreateApplicationsJSONRequestBody defines body for BatchCreateApplications for application/json ContentType.
+type BatchCreateApplicationsJSONRequestBody = BatchCreateRequest
+
+// BatchDeleteApplicationsJSONRequestBody defines body for BatchDeleteApplications for application/json ContentType.
+type BatchDeleteApplicationsJSONRequestBody = BatchDeleteRequest
+
+// PreviewApplicationJSONRequestBody defines body for PreviewApplication for application/json ContentType.
+type PreviewApplicationJSONRequestBody = Application
+
+// SimulatePoliciesJSONRequestBody defines body for SimulatePolicies for application/json ContentType.
+type SimulatePoliciesJSONRequestBody = PolicySimulationRequest
+
+// CreateQuotaJSONRequestBody defines body for CreateQuota for application/json ContentType.
+type CreateQuotaJSONRequestBody = Quota
+
+// UpdateQuotaJSONRequestBody defines body for UpdateQuota for application/json ContentType.
+type UpdateQuotaJSONRequestBody = Quota
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = Subscription
+
+// CreateTierJSONRequestBody defines body for CreateTier for application/json ContentType.
+type CreateTierJSONRequestBody = Tier
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get all applications
+	// (GET /applications)
+	ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams)
+	// Create an application
+	// (POST /applications)
+	CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams)
+	// Delete an application
+	// (DELETE /applications/{id})
+	DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get an application
+	// (GET /applications/{id})
+	GetApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replace an application
+	// (PUT /applications/{id})
+	ReplaceApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the events of an application
+	// (GET /applications/{id}/events)
+	ListApplicationEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListApplicationEventsParams)
+	// Get the placement decision of an application
+	// (GET /applications/{id}/placement-decision)
+	GetPlacementDecision(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Fail an application over
+	// (POST /applications/{id}:failover)
+	FailoverApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Scale an application
+	// (POST /applications/{id}:scale)
+	ScaleApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Create applications in bulk
+	// (POST /applications:batchCreate)
+	BatchCreateApplications(w http.ResponseWriter, r *http.Request)
+	// Delete applications in bulk
+	// (POST /applications:batchDelete)
+	BatchDeleteApplications(w http.ResponseWriter, r *http.Request)
+	// Preview the placement of an application
+	// (POST /applications:preview)
+	PreviewApplication(w http.ResponseWriter, r *http.Request)
+	// Watch applications
+	// (GET /applications:watch)
+	WatchApplications(w http.ResponseWriter, r *http.Request, params WatchApplicationsParams)
+	// List events
+	// (GET /events)
+	ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams)
+	// Health check
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Simulate a candidate policy bundle
+	// (POST /policies:simulate)
+	SimulatePolicies(w http.ResponseWriter, r *http.Request)
+	// List quotas
+	// (GET /quotas)
+	ListQuotas(w http.ResponseWriter, r *http.Request)
+	// Create a quota
+	// (POST /quotas)
+	CreateQuota(w http.ResponseWriter, r *http.Request)
+	// Delete a quota
+	// (DELETE /quotas/{id})
+	DeleteQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a quota
+	// (GET /quotas/{id})
+	GetQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a quota
+	// (PUT /quotas/{id})
+	UpdateQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List subscriptions
+	// (GET /subscriptions)
+	ListSubscriptions(w http.ResponseWriter, r *http.Request)
+	// Create a subscription
+	// (POST /subscriptions)
+	CreateSubscription(w http.ResponseWriter, r *http.Request)
+	// Delete a subscription
+	// (DELETE /subscriptions/{id})
+	DeleteSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a subscription
+	// (GET /subscriptions/{id})
+	GetSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List undelivered notifications
+	// (GET /subscriptions/{id}/dead-letters)
+	ListSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List tiers
+	// (GET /tiers)
+	ListTiers(w http.ResponseWriter, r *http.Request)
+	// Register a tier
+	// (POST /tiers)
+	CreateTier(w http.ResponseWriter, r *http.Request)
+	// List zones
+	// (GET /zones)
+	ListZones(w http.ResponseWriter, r *http.Request)
+	// Get a zone
+	// (GET /zones/{id})
+	GetZone(w http.ResponseWriter, r *http.Request, id string)
+	// Get the drain of a zone
+	// (GET /zones/{id}/drain)
+	GetZoneDrain(w http.ResponseWriter, r *http.Request, id string)
+	// Cordon a zone
+	// (POST /zones/{id}:cordon)
+	CordonZone(w http.ResponseWriter, r *http.Request, id string)
+	// Drain a zone
+	// (POST /zones/{id}:drain)
+	DrainZone(w http.ResponseWriter, r *http.Request, id string)
+	// Uncordon a zone
+	// (POST /zones/{id}:uncordon)
+	UncordonZone(w http.ResponseWriter, r *http.Request, id string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Get all applications
+// (GET /applications)
+func (_ Unimplemented) ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an application
+// (POST /applications)
+func (_ Unimplemented) CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an application
+// (DELETE /applications/{id})
+func (_ Unimplemented) DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an application
+// (GET /applications/{id})
+func (_ Unimplemented) GetApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace an application
+// (PUT /applications/{id})
+func (_ Unimplemented) ReplaceApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the events of an application
+// (GET /applications/{id}/events)
+func (_ Unimplemented) ListApplicationEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListApplicationEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the placement decision of an application
+// (GET /applications/{id}/placement-decision)
+func (_ Unimplemented) GetPlacementDecision(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Fail an application over
+// (POST /applications/{id}:failover)
+func (_ Unimplemented) FailoverApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Scale an application
+// (POST /applications/{id}:scale)
+func (_ Unimplemented) ScaleApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create applications in bulk
+// (POST /applications:batchCreate)
+func (_ Unimplemented) BatchCreateApplications(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete applications in bulk
+// (POST /applications:batchDelete)
+func (_ Unimplemented) BatchDeleteApplications(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the placement of an application
+// (POST /applications:preview)
+func (_ Unimplemented) PreviewApplication(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch applications
+// (GET /applications:watch)
+func (_ Unimplemented) WatchApplications(w http.ResponseWriter, r *http.Request, params WatchApplicationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List events
+// (GET /events)
+func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Health check
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Simulate a candidate policy bundle
+// (POST /policies:simulate)
+func (_ Unimplemented) SimulatePolicies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List quotas
+// (GET /quotas)
+func (_ Unimplemented) ListQuotas(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a quota
+// (POST /quotas)
+func (_ Unimplemented) CreateQuota(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a quota
+// (DELETE /quotas/{id})
+func (_ Unimplemented) DeleteQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a quota
+// (GET /quotas/{id})
+func (_ Unimplemented) GetQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a quota
+// (PUT /quotas/{id})
+func (_ Unimplemented) UpdateQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List subscriptions
+// (GET /subscriptions)
+func (_ Unimplemented) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a subscription
+// (POST /subscriptions)
+func (_ Unimplemented) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a subscription
+// (DELETE /subscriptions/{id})
+func (_ Unimplemented) DeleteSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a subscription
+// (GET /subscriptions/{id})
+func (_ Unimplemented) GetSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List undelivered notifications
+// (GET /subscriptions/{id}/dead-letters)
+func (_ Unimplemented) ListSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tiers
+// (GET /tiers)
+func (_ Unimplemented) ListTiers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a tier
+// (POST /tiers)
+func (_ Unimplemented) CreateTier(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List zones
+// (GET /zones)
+func (_ Unimplemented) ListZones(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a zone
+// (GET /zones/{id})
+func (_ Unimplemented) GetZone(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the drain of a zone
+// (GET /zones/{id}/drain)
+func (_ Unimplemented) GetZoneDrain(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cordon a zone
+// (POST /zones/{id}:cordon)
+func (_ Unimplemented) CordonZone(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Drain a zone
+// (POST /zones/{id}:drain)
+func (_ Unimplemented) DrainZone(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Uncordon a zone
+// (POST /zones/{id}:uncordon)
+func (_ Unimplemented) UncordonZone(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationsParams
+
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label_selector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateApplication operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateApplicationParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wait_for_ready" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait_for_ready", r.URL.Query(), &params.WaitForReady)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait_for_ready", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ready_timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ready_timeout", r.URL.Query(), &params.ReadyTimeout)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ready_timeout", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rollback_on_failure" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rollback_on_failure", r.URL.Query(), &params.RollbackOnFailure)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rollback_on_failure", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplication(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApplication operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApplication(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApplication operation middleware
+func (siw *ServerInterfaceWrapper) GetApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplication(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceApplication operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceApplication(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListApplicationEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationEventsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationEvents(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlacementDecision operation middleware
+func (siw *ServerInterfaceWrapper) GetPlacementDecision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlacementDecision(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// FailoverApplication operation middleware
+func (siw *ServerInterfaceWrapper) FailoverApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FailoverApplication(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ScaleApplication operation middleware
+func (siw *ServerInterfaceWrapper) ScaleApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScaleApplication(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BatchCreateApplications operation middleware
+func (siw *ServerInterfaceWrapper) BatchCreateApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchCreateApplications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BatchDeleteApplications operation middleware
+func (siw *ServerInterfaceWrapper) BatchDeleteApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchDeleteApplications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PreviewApplication operation middleware
+func (siw *ServerInterfaceWrapper) PreviewApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewApplication(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// WatchApplications operation middleware
+func (siw *ServerInterfaceWrapper) WatchApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchApplicationsParams
+
+	// ------------- Optional query parameter "resource_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource_version", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchApplications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
+
+	// ------------- Optional query parameter "application_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application_id", r.URL.Query(), &params.ApplicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "application_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SimulatePolicies operation middleware
+func (siw *ServerInterfaceWrapper) SimulatePolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulatePolicies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListQuotas operation middleware
+func (siw *ServerInterfaceWrapper) ListQuotas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuotas(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateQuota operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuota(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteQuota operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteQuota(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuota(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateQuota operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateQuota(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSubscriptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSubscription(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscription(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListSubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ListSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSubscriptionDeadLetters(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListTiers operation middleware
+func (siw *ServerInterfaceWrapper) ListTiers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTiers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateTier operation middleware
+func (siw *ServerInterfaceWrapper) CreateTier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTier(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListZones operation middleware
+func (siw *ServerInterfaceWrapper) ListZones(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListZones(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetZone operation middleware
+func (siw *ServerInterfaceWrapper) GetZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetZone(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetZoneDrain operation middleware
+func (siw *ServerInterfaceWrapper) GetZoneDrain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetZoneDrain(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CordonZone operation middleware
+func (siw *ServerInterfaceWrapper) CordonZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CordonZone(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DrainZone operation middleware
+func (siw *ServerInterfaceWrapper) DrainZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DrainZone(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UncordonZone operation middleware
+func (siw *ServerInterfaceWrapper) UncordonZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UncordonZone(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
 type InvalidParamFormatError struct {
@@ -320,269 +2086,2421 @@ type InvalidParamFormatError struct {
 	Err       error
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications", wrapper.ListApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications", wrapper.CreateApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/applications/{id}", wrapper.DeleteApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/{id}", wrapper.GetApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/{id}", wrapper.ReplaceApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/{id}/events", wrapper.ListApplicationEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/{id}/placement-decision", wrapper.GetPlacementDecision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications/{id}:failover", wrapper.FailoverApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications/{id}:scale", wrapper.ScaleApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications:batchCreate", wrapper.BatchCreateApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications:batchDelete", wrapper.BatchDeleteApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications:preview", wrapper.PreviewApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications:watch", wrapper.WatchApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events", wrapper.ListEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:simulate", wrapper.SimulatePolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quotas", wrapper.ListQuotas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quotas", wrapper.CreateQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/quotas/{id}", wrapper.DeleteQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quotas/{id}", wrapper.GetQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/quotas/{id}", wrapper.UpdateQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions", wrapper.ListSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions", wrapper.CreateSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/subscriptions/{id}", wrapper.DeleteSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/{id}", wrapper.GetSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/{id}/dead-letters", wrapper.ListSubscriptionDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tiers", wrapper.ListTiers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tiers", wrapper.CreateTier)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/zones", wrapper.ListZones)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/zones/{id}", wrapper.GetZone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/zones/{id}/drain", wrapper.GetZoneDrain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/zones/{id}:cordon", wrapper.CordonZone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/zones/{id}:drain", wrapper.DrainZone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/zones/{id}:uncordon", wrapper.UncordonZone)
+	})
+
+	return r
+}
+
+type ListApplicationsRequestObject struct {
+	Params ListApplicationsParams
+}
+
+type ListApplicationsResponseObject interface {
+	VisitListApplicationsResponse(w http.ResponseWriter) error
+}
+
+type ListApplications200JSONResponse ApplicationList
+
+func (response ListApplications200JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApplications400JSONResponse Error
+
+func (response ListApplications400JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApplications500JSONResponse Error
+
+func (response ListApplications500JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApplicationRequestObject struct {
+	Params CreateApplicationParams
+	Body   *CreateApplicationJSONRequestBody
+}
+
+type CreateApplicationResponseObject interface {
+	VisitCreateApplicationResponse(w http.ResponseWriter) error
+}
+
+type CreateApplication201JSONResponse ApplicationResponse
+
+func (response CreateApplication201JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApplication400JSONResponse Error
+
+func (response CreateApplication400JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApplication403JSONResponse Error
+
+func (response CreateApplication403JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApplication500JSONResponse Error
+
+func (response CreateApplication500JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApplicationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteApplicationResponseObject interface {
+	VisitDeleteApplicationResponse(w http.ResponseWriter) error
+}
+
+type DeleteApplication204JSONResponse ApplicationResponse
+
+func (response DeleteApplication204JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(204)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApplication400JSONResponse Error
+
+func (response DeleteApplication400JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApplication500JSONResponse Error
+
+func (response DeleteApplication500JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApplicationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetApplicationResponseObject interface {
+	VisitGetApplicationResponse(w http.ResponseWriter) error
+}
+
+type GetApplication200JSONResponse ApplicationResponse
+
+func (response GetApplication200JSONResponse) VisitGetApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApplication404JSONResponse Error
+
+func (response GetApplication404JSONResponse) VisitGetApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApplication500JSONResponse Error
+
+func (response GetApplication500JSONResponse) VisitGetApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceApplicationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ReplaceApplicationJSONRequestBody
+}
+
+type ReplaceApplicationResponseObject interface {
+	VisitReplaceApplicationResponse(w http.ResponseWriter) error
+}
+
+type ReplaceApplication200JSONResponse ApplicationResponse
+
+func (response ReplaceApplication200JSONResponse) VisitReplaceApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceApplication400JSONResponse Error
+
+func (response ReplaceApplication400JSONResponse) VisitReplaceApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceApplication403JSONResponse Error
+
+func (response ReplaceApplication403JSONResponse) VisitReplaceApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceApplication404JSONResponse Error
+
+func (response ReplaceApplication404JSONResponse) VisitReplaceApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceApplication500JSONResponse Error
+
+func (response ReplaceApplication500JSONResponse) VisitReplaceApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApplicationEventsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListApplicationEventsParams
+}
+
+type ListApplicationEventsResponseObject interface {
+	VisitListApplicationEventsResponse(w http.ResponseWriter) error
+}
+
+type ListApplicationEvents200JSONResponse EventList
+
+func (response ListApplicationEvents200JSONResponse) VisitListApplicationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApplicationEvents400JSONResponse Error
+
+func (response ListApplicationEvents400JSONResponse) VisitListApplicationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApplicationEvents500JSONResponse Error
+
+func (response ListApplicationEvents500JSONResponse) VisitListApplicationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlacementDecisionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPlacementDecisionResponseObject interface {
+	VisitGetPlacementDecisionResponse(w http.ResponseWriter) error
+}
+
+type GetPlacementDecision200JSONResponse PlacementDecision
+
+func (response GetPlacementDecision200JSONResponse) VisitGetPlacementDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlacementDecision404JSONResponse Error
+
+func (response GetPlacementDecision404JSONResponse) VisitGetPlacementDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlacementDecision500JSONResponse Error
+
+func (response GetPlacementDecision500JSONResponse) VisitGetPlacementDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailoverApplicationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *FailoverApplicationJSONRequestBody
+}
+
+type FailoverApplicationResponseObject interface {
+	VisitFailoverApplicationResponse(w http.ResponseWriter) error
+}
+
+type FailoverApplication200JSONResponse ApplicationResponse
+
+func (response FailoverApplication200JSONResponse) VisitFailoverApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailoverApplication400JSONResponse Error
+
+func (response FailoverApplication400JSONResponse) VisitFailoverApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailoverApplication404JSONResponse Error
+
+func (response FailoverApplication404JSONResponse) VisitFailoverApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailoverApplication409JSONResponse Error
+
+func (response FailoverApplication409JSONResponse) VisitFailoverApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FailoverApplication500JSONResponse Error
+
+func (response FailoverApplication500JSONResponse) VisitFailoverApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleApplicationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ScaleApplicationJSONRequestBody
+}
+
+type ScaleApplicationResponseObject interface {
+	VisitScaleApplicationResponse(w http.ResponseWriter) error
+}
+
+type ScaleApplication200JSONResponse ApplicationResponse
+
+func (response ScaleApplication200JSONResponse) VisitScaleApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleApplication400JSONResponse Error
+
+func (response ScaleApplication400JSONResponse) VisitScaleApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleApplication403JSONResponse Error
+
+func (response ScaleApplication403JSONResponse) VisitScaleApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleApplication404JSONResponse Error
+
+func (response ScaleApplication404JSONResponse) VisitScaleApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleApplication500JSONResponse Error
+
+func (response ScaleApplication500JSONResponse) VisitScaleApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateApplicationsRequestObject struct {
+	Body *BatchCreateApplicationsJSONRequestBody
+}
+
+type BatchCreateApplicationsResponseObject interface {
+	VisitBatchCreateApplicationsResponse(w http.ResponseWriter) error
+}
+
+type BatchCreateApplications200JSONResponse BatchResponse
+
+func (response BatchCreateApplications200JSONResponse) VisitBatchCreateApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateApplications400JSONResponse Error
+
+func (response BatchCreateApplications400JSONResponse) VisitBatchCreateApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateApplications500JSONResponse Error
+
+func (response BatchCreateApplications500JSONResponse) VisitBatchCreateApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteApplicationsRequestObject struct {
+	Body *BatchDeleteApplicationsJSONRequestBody
+}
+
+type BatchDeleteApplicationsResponseObject interface {
+	VisitBatchDeleteApplicationsResponse(w http.ResponseWriter) error
+}
+
+type BatchDeleteApplications200JSONResponse BatchResponse
+
+func (response BatchDeleteApplications200JSONResponse) VisitBatchDeleteApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteApplications400JSONResponse Error
+
+func (response BatchDeleteApplications400JSONResponse) VisitBatchDeleteApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteApplications500JSONResponse Error
+
+func (response BatchDeleteApplications500JSONResponse) VisitBatchDeleteApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewApplicationRequestObject struct {
+	Body *PreviewApplicationJSONRequestBody
+}
+
+type PreviewApplicationResponseObject interface {
+	VisitPreviewApplicationResponse(w http.ResponseWriter) error
+}
+
+type PreviewApplication200JSONResponse PlacementPreview
+
+func (response PreviewApplication200JSONResponse) VisitPreviewApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewApplication400JSONResponse Error
+
+func (response PreviewApplication400JSONResponse) VisitPreviewApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewApplication500JSONResponse Error
+
+func (response PreviewApplication500JSONResponse) VisitPreviewApplicationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchApplicationsRequestObject struct {
+	Params WatchApplicationsParams
+}
+
+type WatchApplicationsResponseObject interface {
+	VisitWatchApplicationsResponse(w http.ResponseWriter) error
+}
+
+type WatchApplications200TexteventstreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response WatchApplications200TexteventstreamResponse) VisitWatchApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WatchApplications400JSONResponse Error
+
+func (response WatchApplications400JSONResponse) VisitWatchApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchApplications410JSONResponse Error
+
+func (response WatchApplications410JSONResponse) VisitWatchApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchApplications500JSONResponse Error
+
+func (response WatchApplications500JSONResponse) VisitWatchApplicationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventsRequestObject struct {
+	Params ListEventsParams
+}
+
+type ListEventsResponseObject interface {
+	VisitListEventsResponse(w http.ResponseWriter) error
+}
+
+type ListEvents200JSONResponse EventList
+
+func (response ListEvents200JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents400JSONResponse Error
+
+func (response ListEvents400JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents500JSONResponse Error
+
+func (response ListEvents500JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse Health
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePoliciesRequestObject struct {
+	Body *SimulatePoliciesJSONRequestBody
+}
+
+type SimulatePoliciesResponseObject interface {
+	VisitSimulatePoliciesResponse(w http.ResponseWriter) error
+}
+
+type SimulatePolicies200JSONResponse PolicySimulation
+
+func (response SimulatePolicies200JSONResponse) VisitSimulatePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePolicies400JSONResponse Error
+
+func (response SimulatePolicies400JSONResponse) VisitSimulatePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePolicies500JSONResponse Error
+
+func (response SimulatePolicies500JSONResponse) VisitSimulatePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotasRequestObject struct {
+}
+
+type ListQuotasResponseObject interface {
+	VisitListQuotasResponse(w http.ResponseWriter) error
+}
+
+type ListQuotas200JSONResponse QuotaList
+
+func (response ListQuotas200JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotas500JSONResponse Error
+
+func (response ListQuotas500JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuotaRequestObject struct {
+	Body *CreateQuotaJSONRequestBody
+}
+
+type CreateQuotaResponseObject interface {
+	VisitCreateQuotaResponse(w http.ResponseWriter) error
+}
+
+type CreateQuota201JSONResponse Quota
+
+func (response CreateQuota201JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota400JSONResponse Error
+
+func (response CreateQuota400JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota409JSONResponse Error
+
+func (response CreateQuota409JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota500JSONResponse Error
+
+func (response CreateQuota500JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuotaRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteQuotaResponseObject interface {
+	VisitDeleteQuotaResponse(w http.ResponseWriter) error
+}
+
+type DeleteQuota204Response struct {
+}
+
+func (response DeleteQuota204Response) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteQuota404JSONResponse Error
+
+func (response DeleteQuota404JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuota500JSONResponse Error
+
+func (response DeleteQuota500JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetQuotaResponseObject interface {
+	VisitGetQuotaResponse(w http.ResponseWriter) error
+}
+
+type GetQuota200JSONResponse Quota
+
+func (response GetQuota200JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota404JSONResponse Error
+
+func (response GetQuota404JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota500JSONResponse Error
+
+func (response GetQuota500JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQuotaRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateQuotaJSONRequestBody
+}
+
+type UpdateQuotaResponseObject interface {
+	VisitUpdateQuotaResponse(w http.ResponseWriter) error
+}
+
+type UpdateQuota200JSONResponse Quota
+
+func (response UpdateQuota200JSONResponse) VisitUpdateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQuota400JSONResponse Error
+
+func (response UpdateQuota400JSONResponse) VisitUpdateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQuota404JSONResponse Error
+
+func (response UpdateQuota404JSONResponse) VisitUpdateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQuota500JSONResponse Error
+
+func (response UpdateQuota500JSONResponse) VisitUpdateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSubscriptionsRequestObject struct {
+}
+
+type ListSubscriptionsResponseObject interface {
+	VisitListSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type ListSubscriptions200JSONResponse SubscriptionList
+
+func (response ListSubscriptions200JSONResponse) VisitListSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSubscriptions500JSONResponse Error
+
+func (response ListSubscriptions500JSONResponse) VisitListSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscriptionRequestObject struct {
+	Body *CreateSubscriptionJSONRequestBody
+}
+
+type CreateSubscriptionResponseObject interface {
+	VisitCreateSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type CreateSubscription201JSONResponse Subscription
+
+func (response CreateSubscription201JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription400JSONResponse Error
+
+func (response CreateSubscription400JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription500JSONResponse Error
+
+func (response CreateSubscription500JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteSubscriptionResponseObject interface {
+	VisitDeleteSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscription204Response struct {
+}
+
+func (response DeleteSubscription204Response) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSubscription404JSONResponse Error
+
+func (response DeleteSubscription404JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscription500JSONResponse Error
+
+func (response DeleteSubscription500JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetSubscriptionResponseObject interface {
+	VisitGetSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetSubscription200JSONResponse Subscription
+
+func (response GetSubscription200JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscription404JSONResponse Error
+
+func (response GetSubscription404JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscription500JSONResponse Error
+
+func (response GetSubscription500JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSubscriptionDeadLettersRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListSubscriptionDeadLettersResponseObject interface {
+	VisitListSubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type ListSubscriptionDeadLetters200JSONResponse DeadLetterList
+
+func (response ListSubscriptionDeadLetters200JSONResponse) VisitListSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSubscriptionDeadLetters404JSONResponse Error
+
+func (response ListSubscriptionDeadLetters404JSONResponse) VisitListSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSubscriptionDeadLetters500JSONResponse Error
+
+func (response ListSubscriptionDeadLetters500JSONResponse) VisitListSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTiersRequestObject struct {
+}
+
+type ListTiersResponseObject interface {
+	VisitListTiersResponse(w http.ResponseWriter) error
+}
+
+type ListTiers200JSONResponse TierList
+
+func (response ListTiers200JSONResponse) VisitListTiersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTiers500JSONResponse Error
+
+func (response ListTiers500JSONResponse) VisitListTiersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTierRequestObject struct {
+	Body *CreateTierJSONRequestBody
+}
+
+type CreateTierResponseObject interface {
+	VisitCreateTierResponse(w http.ResponseWriter) error
+}
+
+type CreateTier201JSONResponse Tier
+
+func (response CreateTier201JSONResponse) VisitCreateTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTier400JSONResponse Error
+
+func (response CreateTier400JSONResponse) VisitCreateTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTier409JSONResponse Error
+
+func (response CreateTier409JSONResponse) VisitCreateTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTier500JSONResponse Error
+
+func (response CreateTier500JSONResponse) VisitCreateTierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListZonesRequestObject struct {
+}
+
+type ListZonesResponseObject interface {
+	VisitListZonesResponse(w http.ResponseWriter) error
+}
+
+type ListZones200JSONResponse ZoneList
+
+func (response ListZones200JSONResponse) VisitListZonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListZones500JSONResponse Error
+
+func (response ListZones500JSONResponse) VisitListZonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZoneRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetZoneResponseObject interface {
+	VisitGetZoneResponse(w http.ResponseWriter) error
+}
+
+type GetZone200JSONResponse Zone
+
+func (response GetZone200JSONResponse) VisitGetZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZone404JSONResponse Error
+
+func (response GetZone404JSONResponse) VisitGetZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZone500JSONResponse Error
+
+func (response GetZone500JSONResponse) VisitGetZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZoneDrainRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetZoneDrainResponseObject interface {
+	VisitGetZoneDrainResponse(w http.ResponseWriter) error
+}
+
+type GetZoneDrain200JSONResponse ZoneDrain
+
+func (response GetZoneDrain200JSONResponse) VisitGetZoneDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZoneDrain404JSONResponse Error
+
+func (response GetZoneDrain404JSONResponse) VisitGetZoneDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetZoneDrain500JSONResponse Error
+
+func (response GetZoneDrain500JSONResponse) VisitGetZoneDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonZoneRequestObject struct {
+	Id string `json:"id"`
+}
+
+type CordonZoneResponseObject interface {
+	VisitCordonZoneResponse(w http.ResponseWriter) error
+}
+
+type CordonZone200JSONResponse Zone
+
+func (response CordonZone200JSONResponse) VisitCordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonZone404JSONResponse Error
+
+func (response CordonZone404JSONResponse) VisitCordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonZone500JSONResponse Error
+
+func (response CordonZone500JSONResponse) VisitCordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainZoneRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DrainZoneResponseObject interface {
+	VisitDrainZoneResponse(w http.ResponseWriter) error
+}
+
+type DrainZone202JSONResponse ZoneDrain
+
+func (response DrainZone202JSONResponse) VisitDrainZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainZone404JSONResponse Error
+
+func (response DrainZone404JSONResponse) VisitDrainZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainZone409JSONResponse Error
+
+func (response DrainZone409JSONResponse) VisitDrainZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainZone500JSONResponse Error
+
+func (response DrainZone500JSONResponse) VisitDrainZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonZoneRequestObject struct {
+	Id string `json:"id"`
+}
+
+type UncordonZoneResponseObject interface {
+	VisitUncordonZoneResponse(w http.ResponseWriter) error
+}
+
+type UncordonZone200JSONResponse Zone
+
+func (response UncordonZone200JSONResponse) VisitUncordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonZone404JSONResponse Error
+
+func (response UncordonZone404JSONResponse) VisitUncordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonZone500JSONResponse Error
+
+func (response UncordonZone500JSONResponse) VisitUncordonZoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get all applications
+	// (GET /applications)
+	ListApplications(ctx context.Context, request ListApplicationsRequestObject) (ListApplicationsResponseObject, error)
+	// Create an application
+	// (POST /applications)
+	CreateApplication(ctx context.Context, request CreateApplicationRequestObject) (CreateApplicationResponseObject, error)
+	// Delete an application
+	// (DELETE /applications/{id})
+	DeleteApplication(ctx context.Context, request DeleteApplicationRequestObject) (DeleteApplicationResponseObject, error)
+	// Get an application
+	// (GET /applications/{id})
+	GetApplication(ctx context.Context, request GetApplicationRequestObject) (GetApplicationResponseObject, error)
+	// Replace an application
+	// (PUT /applications/{id})
+	ReplaceApplication(ctx context.Context, request ReplaceApplicationRequestObject) (ReplaceApplicationResponseObject, error)
+	// List the events of an application
+	// (GET /applications/{id}/events)
+	ListApplicationEvents(ctx context.Context, request ListApplicationEventsRequestObject) (ListApplicationEventsResponseObject, error)
+	// Get the placement decision of an application
+	// (GET /applications/{id}/placement-decision)
+	GetPlacementDecision(ctx context.Context, request GetPlacementDecisionRequestObject) (GetPlacementDecisionResponseObject, error)
+	// Fail an application over
+	// (POST /applications/{id}:failover)
+	FailoverApplication(ctx context.Context, request FailoverApplicationRequestObject) (FailoverApplicationResponseObject, error)
+	// Scale an application
+	// (POST /applications/{id}:scale)
+	ScaleApplication(ctx context.Context, request ScaleApplicationRequestObject) (ScaleApplicationResponseObject, error)
+	// Create applications in bulk
+	// (POST /applications:batchCreate)
+	BatchCreateApplications(ctx context.Context, request BatchCreateApplicationsRequestObject) (BatchCreateApplicationsResponseObject, error)
+	// Delete applications in bulk
+	// (POST /applications:batchDelete)
+	BatchDeleteApplications(ctx context.Context, request BatchDeleteApplicationsRequestObject) (BatchDeleteApplicationsResponseObject, error)
+	// Preview the placement of an application
+	// (POST /applications:preview)
+	PreviewApplication(ctx context.Context, request PreviewApplicationRequestObject) (PreviewApplicationResponseObject, error)
+	// Watch applications
+	// (GET /applications:watch)
+	WatchApplications(ctx context.Context, request WatchApplicationsRequestObject) (WatchApplicationsResponseObject, error)
+	// List events
+	// (GET /events)
+	ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error)
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Simulate a candidate policy bundle
+	// (POST /policies:simulate)
+	SimulatePolicies(ctx context.Context, request SimulatePoliciesRequestObject) (SimulatePoliciesResponseObject, error)
+	// List quotas
+	// (GET /quotas)
+	ListQuotas(ctx context.Context, request ListQuotasRequestObject) (ListQuotasResponseObject, error)
+	// Create a quota
+	// (POST /quotas)
+	CreateQuota(ctx context.Context, request CreateQuotaRequestObject) (CreateQuotaResponseObject, error)
+	// Delete a quota
+	// (DELETE /quotas/{id})
+	DeleteQuota(ctx context.Context, request DeleteQuotaRequestObject) (DeleteQuotaResponseObject, error)
+	// Get a quota
+	// (GET /quotas/{id})
+	GetQuota(ctx context.Context, request GetQuotaRequestObject) (GetQuotaResponseObject, error)
+	// Update a quota
+	// (PUT /quotas/{id})
+	UpdateQuota(ctx context.Context, request UpdateQuotaRequestObject) (UpdateQuotaResponseObject, error)
+	// List subscriptions
+	// (GET /subscriptions)
+	ListSubscriptions(ctx context.Context, request ListSubscriptionsRequestObject) (ListSubscriptionsResponseObject, error)
+	// Create a subscription
+	// (POST /subscriptions)
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject) (CreateSubscriptionResponseObject, error)
+	// Delete a subscription
+	// (DELETE /subscriptions/{id})
+	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject) (DeleteSubscriptionResponseObject, error)
+	// Get a subscription
+	// (GET /subscriptions/{id})
+	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// List undelivered notifications
+	// (GET /subscriptions/{id}/dead-letters)
+	ListSubscriptionDeadLetters(ctx context.Context, request ListSubscriptionDeadLettersRequestObject) (ListSubscriptionDeadLettersResponseObject, error)
+	// List tiers
+	// (GET /tiers)
+	ListTiers(ctx context.Context, request ListTiersRequestObject) (ListTiersResponseObject, error)
+	// Register a tier
+	// (POST /tiers)
+	CreateTier(ctx context.Context, request CreateTierRequestObject) (CreateTierResponseObject, error)
+	// List zones
+	// (GET /zones)
+	ListZones(ctx context.Context, request ListZonesRequestObject) (ListZonesResponseObject, error)
+	// Get a zone
+	// (GET /zones/{id})
+	GetZone(ctx context.Context, request GetZoneRequestObject) (GetZoneResponseObject, error)
+	// Get the drain of a zone
+	// (GET /zones/{id}/drain)
+	GetZoneDrain(ctx context.Context, request GetZoneDrainRequestObject) (GetZoneDrainResponseObject, error)
+	// Cordon a zone
+	// (POST /zones/{id}:cordon)
+	CordonZone(ctx context.Context, request CordonZoneRequestObject) (CordonZoneResponseObject, error)
+	// Drain a zone
+	// (POST /zones/{id}:drain)
+	DrainZone(ctx context.Context, request DrainZoneRequestObject) (DrainZoneResponseObject, error)
+	// Uncordon a zone
+	// (POST /zones/{id}:uncordon)
+	UncordonZone(ctx context.Context, request UncordonZoneRequestObject) (UncordonZoneResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListApplications operation middleware
+func (sh *strictHandler) ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams) {
+	var request ListApplicationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApplications(ctx, request.(ListApplicationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApplications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApplicationsResponseObject); ok {
+		if err := validResponse.VisitListApplicationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateApplication operation middleware
+func (sh *strictHandler) CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams) {
+	var request CreateApplicationRequestObject
+
+	request.Params = params
+
+	var body CreateApplicationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApplication(ctx, request.(CreateApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateApplicationResponseObject); ok {
+		if err := validResponse.VisitCreateApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApplication operation middleware
+func (sh *strictHandler) DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteApplicationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApplication(ctx, request.(DeleteApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApplicationResponseObject); ok {
+		if err := validResponse.VisitDeleteApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApplication operation middleware
+func (sh *strictHandler) GetApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetApplicationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApplication(ctx, request.(GetApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApplicationResponseObject); ok {
+		if err := validResponse.VisitGetApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaceApplication operation middleware
+func (sh *strictHandler) ReplaceApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ReplaceApplicationRequestObject
+
+	request.Id = id
+
+	var body ReplaceApplicationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceApplication(ctx, request.(ReplaceApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceApplicationResponseObject); ok {
+		if err := validResponse.VisitReplaceApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApplicationEvents operation middleware
+func (sh *strictHandler) ListApplicationEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListApplicationEventsParams) {
+	var request ListApplicationEventsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApplicationEvents(ctx, request.(ListApplicationEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApplicationEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApplicationEventsResponseObject); ok {
+		if err := validResponse.VisitListApplicationEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPlacementDecision operation middleware
+func (sh *strictHandler) GetPlacementDecision(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPlacementDecisionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlacementDecision(ctx, request.(GetPlacementDecisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlacementDecision")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlacementDecisionResponseObject); ok {
+		if err := validResponse.VisitGetPlacementDecisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FailoverApplication operation middleware
+func (sh *strictHandler) FailoverApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request FailoverApplicationRequestObject
+
+	request.Id = id
+
+	var body FailoverApplicationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FailoverApplication(ctx, request.(FailoverApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FailoverApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FailoverApplicationResponseObject); ok {
+		if err := validResponse.VisitFailoverApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScaleApplication operation middleware
+func (sh *strictHandler) ScaleApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ScaleApplicationRequestObject
+
+	request.Id = id
+
+	var body ScaleApplicationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ScaleApplication(ctx, request.(ScaleApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScaleApplication")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ScaleApplicationResponseObject); ok {
+		if err := validResponse.VisitScaleApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchCreateApplications operation middleware
+func (sh *strictHandler) BatchCreateApplications(w http.ResponseWriter, r *http.Request) {
+	var request BatchCreateApplicationsRequestObject
+
+	var body BatchCreateApplicationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchCreateApplications(ctx, request.(BatchCreateApplicationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchCreateApplications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchCreateApplicationsResponseObject); ok {
+		if err := validResponse.VisitBatchCreateApplicationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchDeleteApplications operation middleware
+func (sh *strictHandler) BatchDeleteApplications(w http.ResponseWriter, r *http.Request) {
+	var request BatchDeleteApplicationsRequestObject
+
+	var body BatchDeleteApplicationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchDeleteApplications(ctx, request.(BatchDeleteApplicationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchDeleteApplications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchDeleteApplicationsResponseObject); ok {
+		if err := validResponse.VisitBatchDeleteApplicationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewApplication operation middleware
+func (sh *strictHandler) PreviewApplication(w http.ResponseWriter, r *http.Request) {
+	var request PreviewApplicationRequestObject
+
+	var body PreviewApplicationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewApplication(ctx, request.(PreviewApplicationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewApplication")
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewApplicationResponseObject); ok {
+		if err := validResponse.VisitPreviewApplicationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+// WatchApplications operation middleware
+func (sh *strictHandler) WatchApplications(w http.ResponseWriter, r *http.Request, params WatchApplicationsParams) {
+	var request WatchApplicationsRequestObject
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchApplications(ctx, request.(WatchApplicationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchApplications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchApplicationsResponseObject); ok {
+		if err := validResponse.VisitWatchApplicationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// ListEvents operation middleware
+func (sh *strictHandler) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	var request ListEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvents(ctx, request.(ListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEventsResponseObject); ok {
+		if err := validResponse.VisitListEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// SimulatePolicies operation middleware
+func (sh *strictHandler) SimulatePolicies(w http.ResponseWriter, r *http.Request) {
+	var request SimulatePoliciesRequestObject
+
+	var body SimulatePoliciesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulatePolicies(ctx, request.(SimulatePoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulatePolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SimulatePoliciesResponseObject); ok {
+		if err := validResponse.VisitSimulatePoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ListQuotas operation middleware
+func (sh *strictHandler) ListQuotas(w http.ResponseWriter, r *http.Request) {
+	var request ListQuotasRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuotas(ctx, request.(ListQuotasRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuotas")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuotasResponseObject); ok {
+		if err := validResponse.VisitListQuotasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+}
+
+// CreateQuota operation middleware
+func (sh *strictHandler) CreateQuota(w http.ResponseWriter, r *http.Request) {
+	var request CreateQuotaRequestObject
+
+	var body CreateQuotaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/applications", wrapper.ListApplications)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/applications", wrapper.CreateApplication)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/applications/{id}", wrapper.DeleteApplication)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateQuota(ctx, request.(CreateQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateQuota")
+	}
 
-	return r
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateQuotaResponseObject); ok {
+		if err := validResponse.VisitCreateQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListApplicationsRequestObject struct {
-	Params ListApplicationsParams
+// DeleteQuota operation middleware
+func (sh *strictHandler) DeleteQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteQuotaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteQuota(ctx, request.(DeleteQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteQuotaResponseObject); ok {
+		if err := validResponse.VisitDeleteQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListApplicationsResponseObject interface {
-	VisitListApplicationsResponse(w http.ResponseWriter) error
+// GetQuota operation middleware
+func (sh *strictHandler) GetQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetQuotaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuota(ctx, request.(GetQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuotaResponseObject); ok {
+		if err := validResponse.VisitGetQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListApplications200JSONResponse ApplicationList
+// UpdateQuota operation middleware
+func (sh *strictHandler) UpdateQuota(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateQuotaRequestObject
 
-func (response ListApplications200JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	var body UpdateQuotaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateQuota(ctx, request.(UpdateQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateQuotaResponseObject); ok {
+		if err := validResponse.VisitUpdateQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListApplications400JSONResponse Error
+// ListSubscriptions operation middleware
+func (sh *strictHandler) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var request ListSubscriptionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSubscriptions(ctx, request.(ListSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitListSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response ListApplications400JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateSubscription operation middleware
+func (sh *strictHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var request CreateSubscriptionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateSubscriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListApplications500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSubscription(ctx, request.(CreateSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSubscription")
+	}
 
-func (response ListApplications500JSONResponse) VisitListApplicationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateSubscriptionResponseObject); ok {
+		if err := validResponse.VisitCreateSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateApplicationRequestObject struct {
-	Params CreateApplicationParams
-	Body   *CreateApplicationJSONRequestBody
-}
+// DeleteSubscription operation middleware
+func (sh *strictHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteSubscriptionRequestObject
 
-type CreateApplicationResponseObject interface {
-	VisitCreateApplicationResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type CreateApplication201JSONResponse ApplicationResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscription(ctx, request.(DeleteSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscription")
+	}
 
-func (response CreateApplication201JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSubscriptionResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateApplication400JSONResponse Error
-
-func (response CreateApplication400JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetSubscription operation middleware
+func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetSubscriptionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type CreateApplication500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscription(ctx, request.(GetSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscription")
+	}
 
-func (response CreateApplication500JSONResponse) VisitCreateApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteApplicationRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// ListSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) ListSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListSubscriptionDeadLettersRequestObject
 
-type DeleteApplicationResponseObject interface {
-	VisitDeleteApplicationResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type DeleteApplication204JSONResponse ApplicationResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSubscriptionDeadLetters(ctx, request.(ListSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSubscriptionDeadLetters")
+	}
 
-func (response DeleteApplication204JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitListSubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteApplication400JSONResponse Error
+// ListTiers operation middleware
+func (sh *strictHandler) ListTiers(w http.ResponseWriter, r *http.Request) {
+	var request ListTiersRequestObject
 
-func (response DeleteApplication400JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTiers(ctx, request.(ListTiersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTiers")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTiersResponseObject); ok {
+		if err := validResponse.VisitListTiersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteApplication500JSONResponse Error
+// CreateTier operation middleware
+func (sh *strictHandler) CreateTier(w http.ResponseWriter, r *http.Request) {
+	var request CreateTierRequestObject
 
-func (response DeleteApplication500JSONResponse) VisitDeleteApplicationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body CreateTierJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTier(ctx, request.(CreateTierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTier")
+	}
 
-type GetHealthRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTierResponseObject); ok {
+		if err := validResponse.VisitCreateTierResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHealth200JSONResponse Health
+// ListZones operation middleware
+func (sh *strictHandler) ListZones(w http.ResponseWriter, r *http.Request) {
+	var request ListZonesRequestObject
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListZones(ctx, request.(ListZonesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListZones")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get all applications
-	// (GET /applications)
-	ListApplications(ctx context.Context, request ListApplicationsRequestObject) (ListApplicationsResponseObject, error)
-	// Create an application
-	// (POST /applications)
-	CreateApplication(ctx context.Context, request CreateApplicationRequestObject) (CreateApplicationResponseObject, error)
-	// Delete an application
-	// (DELETE /applications/{id})
-	DeleteApplication(ctx context.Context, request DeleteApplicationRequestObject) (DeleteApplicationResponseObject, error)
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListZonesResponseObject); ok {
+		if err := validResponse.VisitListZonesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetZone operation middleware
+func (sh *strictHandler) GetZone(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetZoneRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetZone(ctx, request.(GetZoneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetZone")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetZoneResponseObject); ok {
+		if err := validResponse.VisitGetZoneResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListApplications operation middleware
-func (sh *strictHandler) ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams) {
-	var request ListApplicationsRequestObject
+// GetZoneDrain operation middleware
+func (sh *strictHandler) GetZoneDrain(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetZoneDrainRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListApplications(ctx, request.(ListApplicationsRequestObject))
+		return sh.ssi.GetZoneDrain(ctx, request.(GetZoneDrainRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListApplications")
+		handler = middleware(handler, "GetZoneDrain")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListApplicationsResponseObject); ok {
-		if err := validResponse.VisitListApplicationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetZoneDrainResponseObject); ok {
+		if err := validResponse.VisitGetZoneDrainResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -590,32 +4508,25 @@ func (sh *strictHandler) ListApplications(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateApplication operation middleware
-func (sh *strictHandler) CreateApplication(w http.ResponseWriter, r *http.Request, params CreateApplicationParams) {
-	var request CreateApplicationRequestObject
-
-	request.Params = params
+// CordonZone operation middleware
+func (sh *strictHandler) CordonZone(w http.ResponseWriter, r *http.Request, id string) {
+	var request CordonZoneRequestObject
 
-	var body CreateApplicationJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateApplication(ctx, request.(CreateApplicationRequestObject))
+		return sh.ssi.CordonZone(ctx, request.(CordonZoneRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateApplication")
+		handler = middleware(handler, "CordonZone")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateApplicationResponseObject); ok {
-		if err := validResponse.VisitCreateApplicationResponse(w); err != nil {
+	} else if validResponse, ok := response.(CordonZoneResponseObject); ok {
+		if err := validResponse.VisitCordonZoneResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -623,25 +4534,25 @@ func (sh *strictHandler) CreateApplication(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteApplication operation middleware
-func (sh *strictHandler) DeleteApplication(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteApplicationRequestObject
+// DrainZone operation middleware
+func (sh *strictHandler) DrainZone(w http.ResponseWriter, r *http.Request, id string) {
+	var request DrainZoneRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteApplication(ctx, request.(DeleteApplicationRequestObject))
+		return sh.ssi.DrainZone(ctx, request.(DrainZoneRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteApplication")
+		handler = middleware(handler, "DrainZone")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteApplicationResponseObject); ok {
-		if err := validResponse.VisitDeleteApplicationResponse(w); err != nil {
+	} else if validResponse, ok := response.(DrainZoneResponseObject); ok {
+		if err := validResponse.VisitDrainZoneResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -649,23 +4560,25 @@ func (sh *strictHandler) DeleteApplication(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// UncordonZone operation middleware
+func (sh *strictHandler) UncordonZone(w http.ResponseWriter, r *http.Request, id string) {
+	var request UncordonZoneRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.UncordonZone(ctx, request.(UncordonZoneRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "UncordonZone")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(UncordonZoneResponseObject); ok {
+		if err := validResponse.VisitUncordonZoneResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7,16 +7,21 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/netip"
+	"strings"
 	"time"
 
 	api "github.com/dcm-project/dcm-placement-api/api/v1alpha1"
 	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/capacity"
 	"github.com/dcm-project/dcm-placement-api/internal/config"
 	handlers "github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1"
+	"github.com/dcm-project/dcm-placement-api/internal/httpclient"
 	"github.com/dcm-project/dcm-placement-api/internal/opa"
 	"github.com/dcm-project/dcm-placement-api/internal/provider"
 	"github.com/dcm-project/dcm-placement-api/internal/service"
 	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/webhook"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
@@ -29,10 +34,9 @@ const (
 )
 
 type Server struct {
-	cfg          *config.Config
-	store        store.Store
-	listener     net.Listener
-	opaValidator *opa.Validator
+	cfg      *config.Config
+	store    store.Store
+	listener net.Listener
 }
 
 // New returns a new instance of a migration-planner server.
@@ -52,6 +56,68 @@ func oapiErrorHandler(w http.ResponseWriter, message string, statusCode int) {
 	http.Error(w, fmt.Sprintf("API Error: %s", message), statusCode)
 }
 
+// requestIDHeader returns the request ID to the caller so events can be looked up by it.
+func requestIDHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reqID := middleware.GetReqID(r.Context()); reqID != "" {
+			w.Header().Set(middleware.RequestIDHeader, reqID)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// actorHeader is set by the authenticating proxy in front of the service.
+const actorHeader = "X-Remote-User"
+
+// parseTrustedProxies parses addresses and CIDRs of proxies.
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q, expected an address or a CIDR", proxy)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// actorMiddleware records the caller identity in the request context for the audit log.
+// The actor header is only trusted on connections from one of the trusted proxies.
+func actorMiddleware(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actor := r.Header.Get(actorHeader); actor != "" && fromTrustedProxy(r, trusted) {
+				r = r.WithContext(service.WithActor(r.Context(), actor))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// fromTrustedProxy reports whether the connection of r comes from one of the trusted proxies.
+func fromTrustedProxy(r *http.Request, trusted []netip.Prefix) bool {
+	addrPort, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) Run(ctx context.Context) error {
 	zap.S().Named("api_server").Info("Initializing API server")
 	swagger, err := api.GetSwagger()
@@ -61,6 +127,11 @@ func (s *Server) Run(ctx context.Context) error {
 	// Skip server name validation
 	swagger.Servers = nil
 
+	trustedProxies, err := parseTrustedProxies(s.cfg.Service.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
 	oapiOpts := oapimiddleware.Options{
 		ErrorHandler: oapiErrorHandler,
 	}
@@ -68,7 +139,9 @@ func (s *Server) Run(ctx context.Context) error {
 
 	router.Use(
 		middleware.RequestID,
+		requestIDHeader,
 		middleware.Recoverer,
+		actorMiddleware(trustedProxies),
 	)
 
 	// Add Swagger UI endpoints BEFORE OpenAPI validation middleware
@@ -88,25 +161,26 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	})
 
-	// Initialize provider service client
-	providerService, err := provider.NewService(s.cfg.Service.ProviderServiceUrl)
+	placementService, notifications, err := NewPlacementService(s.cfg, s.store)
 	if err != nil {
-		return fmt.Errorf("failed to initialize provider service: %w", err)
+		return err
+	}
+	// The notifications waiting for a retry are recorded as dead letters on shutdown
+	defer notifications.Close()
+	go placementService.RunZoneSync(ctx, s.cfg.Service.ZoneSyncInterval)
+	if s.cfg.Service.FailoverInterval > 0 {
+		go placementService.RunFailoverReconciler(ctx, s.cfg.Service.FailoverInterval)
 	}
 
 	h := handlers.NewServiceHandler(
 		s.store,
-		service.NewPlacementService(
-			s.store,
-			opa.NewValidator(s.cfg.Service.OpaServer),
-			providerService,
-		),
+		placementService,
 	)
 
 	// Apply OpenAPI validation middleware to API routes only
 	router.Group(func(r chi.Router) {
 		r.Use(oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts))
-		server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
+		server.HandlerFromMux(server.NewStrictHandler(h, []server.StrictMiddlewareFunc{handlers.LastEventID}), router)
 	})
 
 	srv := http.Server{Addr: s.cfg.Service.Address, Handler: router}
@@ -128,3 +202,71 @@ func (s *Server) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// NewPlacementService wires the placement service to the policy engine, the providers and
+// the webhook notifications configured in cfg. The dispatcher is returned so it is closed
+// along with the server.
+func NewPlacementService(cfg *config.Config, store store.Store) (*service.PlacementService, *webhook.Dispatcher, error) {
+	policyEngine, providers, err := backends(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capacities, err := capacity.LoadConfig(cfg.Service.CapacityConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notifications := webhook.NewDispatcher(store, webhook.Config{
+		Timeout:      cfg.Webhook.Timeout,
+		MaxAttempts:  cfg.Webhook.MaxAttempts,
+		RetryBackoff: cfg.Webhook.RetryBackoff,
+		Workers:      cfg.Webhook.Workers,
+		QueueSize:    cfg.Webhook.QueueSize,
+		Source:       cfg.Webhook.Source,
+	})
+	placementService := service.NewPlacementService(store, policyEngine, providers, notifications)
+	placementService.SetCapacities(capacities.Zones)
+	placementService.SetInventoryTTL(cfg.Service.ZoneInventoryTTL)
+	placementService.SetSimulationLimits(cfg.Service.SimulationMaxApplications, cfg.Service.SimulationTimeout)
+	if err := placementService.InitTiers(context.Background()); err != nil {
+		return nil, nil, fmt.Errorf("failed to validate the tier registry: %w", err)
+	}
+	return placementService, notifications, nil
+}
+
+// backends returns the policy engine and provider registry for the configured mode.
+func backends(cfg *config.Config) (opa.Engine, *provider.Registry, error) {
+	if cfg.Service.Mode == config.ModeDev {
+		zap.S().Named("api_server").Warn("Running in dev mode with fake policy engine and provider")
+		providers := provider.NewRegistry()
+		fake := provider.NewFakeProvider(provider.FakeOptions{
+			Latency:    100 * time.Millisecond,
+			ReadyAfter: 5 * time.Second,
+			Namespaces: []provider.Namespace{
+				{Name: "us-east-1", Labels: map[string]string{"tier": "1", "environment": "production"}},
+				{Name: "us-east-2", Labels: map[string]string{"tier": "1", "environment": "production"}},
+				{Name: "us-west-1", Labels: map[string]string{"tier": "2", "environment": "production"}},
+				{Name: "us-central-1", Labels: map[string]string{"tier": "1", "environment": "backup"}},
+				{Name: "us-central-2", Labels: map[string]string{"tier": "2", "environment": "backup"}},
+			},
+		})
+		if err := providers.Register(provider.DefaultProviderName, fake, nil, nil, true); err != nil {
+			return nil, nil, err
+		}
+		return opa.NewDevFakeEngine(), providers, nil
+	}
+
+	// Initialize provider registry
+	providers, err := provider.NewRegistryFromConfig(cfg.Service.ProvidersConfig, cfg.Service.ProviderServiceUrl, cfg.HTTPClientSettings())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize provider registry: %w", err)
+	}
+	// Policy queries have no side effects, retry them regardless of the method
+	opaClient := httpclient.New("opa", cfg.HTTPClientSettings(), httpclient.WithIdempotent(httpclient.AlwaysIdempotent))
+	validator := opa.NewValidator(cfg.Service.OpaServer, opaClient)
+	if err := validator.SetExplain(cfg.Service.OpaExplain); err != nil {
+		return nil, nil, err
+	}
+	return validator, providers, nil
+}
@@ -0,0 +1,101 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	handlers "github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+)
+
+func TestActorMiddleware(t *testing.T) {
+	trusted, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatalf("parseTrustedProxies: %v", err)
+	}
+	var actor string
+	handler := actorMiddleware(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = service.ActorFromContext(r.Context())
+	}))
+
+	for name, tt := range map[string]struct {
+		remoteAddr string
+		basicAuth  bool
+		expected   string
+	}{
+		"trusted CIDR":          {remoteAddr: "10.1.2.3:4000", expected: "alice"},
+		"trusted address":       {remoteAddr: "192.168.1.1:4000", expected: "alice"},
+		"untrusted address":     {remoteAddr: "192.168.1.2:4000", expected: service.AnonymousActor},
+		"basic auth is ignored": {remoteAddr: "172.16.0.1:4000", basicAuth: true, expected: service.AnonymousActor},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/applications", nil)
+		req.RemoteAddr = tt.remoteAddr
+		if tt.basicAuth {
+			req.SetBasicAuth("mallory", "secret")
+		} else {
+			req.Header.Set(actorHeader, "alice")
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if actor != tt.expected {
+			t.Errorf("%s: expected actor %q, got %q", name, tt.expected, actor)
+		}
+	}
+
+	if _, err := parseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Fatal("expected a host name to be rejected")
+	}
+}
+
+// watchRecorder records the parameters of the watches it serves.
+type watchRecorder struct {
+	server.StrictServerInterface
+	params *server.WatchApplicationsParams
+}
+
+func (w *watchRecorder) WatchApplications(ctx context.Context, request server.WatchApplicationsRequestObject) (server.WatchApplicationsResponseObject, error) {
+	w.params = &request.Params
+	return server.WatchApplications410JSONResponse{}, nil
+}
+
+func TestLastEventID(t *testing.T) {
+	ten := int64(10)
+	for name, tt := range map[string]struct {
+		header   string
+		params   server.WatchApplicationsParams
+		expected *int64
+		status   int
+	}{
+		"header":                    {header: "42", expected: ptr(int64(42)), status: http.StatusGone},
+		"resource version first":    {header: "42", params: server.WatchApplicationsParams{ResourceVersion: &ten}, expected: &ten, status: http.StatusGone},
+		"no header":                 {status: http.StatusGone},
+		"not a resource version":    {header: "abc", status: http.StatusBadRequest},
+		"negative resource version": {header: "-1", status: http.StatusBadRequest},
+	} {
+		recorder := &watchRecorder{}
+		handler := server.NewStrictHandler(recorder, []server.StrictMiddlewareFunc{handlers.LastEventID})
+		req := httptest.NewRequest(http.MethodGet, "/applications:watch", nil)
+		if tt.header != "" {
+			req.Header.Set("Last-Event-ID", tt.header)
+		}
+		w := httptest.NewRecorder()
+		handler.WatchApplications(w, req, tt.params)
+		if w.Code != tt.status {
+			t.Errorf("%s: expected status %d, got %d", name, tt.status, w.Code)
+			continue
+		}
+		if tt.status != http.StatusGone {
+			continue
+		}
+		got := recorder.params.ResourceVersion
+		if (got == nil) != (tt.expected == nil) || got != nil && *got != *tt.expected {
+			t.Errorf("%s: expected resource version %v, got %v", name, tt.expected, got)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,132 @@
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the capacity of the zones, read from the capacity configuration file.
+type Config struct {
+	Zones map[string]catalog.Resources `yaml:"zones"`
+}
+
+// LoadConfig reads the capacity configuration file. An empty path leaves the capacity of
+// every zone unknown.
+func LoadConfig(path string) (*Config, error) {
+	cfg := &Config{Zones: map[string]catalog.Resources{}}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capacity config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse capacity config: %w", err)
+	}
+	for zone, capacity := range cfg.Zones {
+		if capacity.Cpu < 0 || capacity.Ram < 0 {
+			return nil, fmt.Errorf("zone %q has a negative capacity", zone)
+		}
+	}
+	return cfg, nil
+}
+
+// Zone is the usage of a zone. Capacity and Available are unset when the capacity of the
+// zone is not configured.
+type Zone struct {
+	Capacity     *catalog.Resources `json:"capacity,omitempty"`
+	Used         catalog.Resources  `json:"used"`
+	Available    *catalog.Resources `json:"available,omitempty"`
+	Applications int                `json:"applications"`
+}
+
+// Ledger accounts for the resources reserved in every zone by the stored applications,
+// using the catalog specs of their service.
+type Ledger struct {
+	store      store.Store
+	mu         sync.RWMutex
+	capacities map[string]catalog.Resources
+}
+
+func NewLedger(store store.Store, capacities map[string]catalog.Resources) *Ledger {
+	l := &Ledger{store: store, capacities: map[string]catalog.Resources{}}
+	maps.Copy(l.capacities, capacities)
+	return l
+}
+
+// SetCapacity sets the capacity of zone.
+func (l *Ledger) SetCapacity(zone string, capacity catalog.Resources) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.capacities[zone] = capacity
+}
+
+// Usage returns the usage of every zone hosting an application or with a configured capacity.
+func (l *Ledger) Usage(ctx context.Context) (map[string]Zone, error) {
+	usage := map[string]Zone{}
+
+	var pageToken *string
+	for {
+		apps, next, err := l.store.Application().List(ctx, store.ApplicationFilter{}, nil, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+		for _, app := range apps {
+			for _, zone := range app.Zones {
+				z := usage[zone]
+				z.Used = z.Used.Add(Requirements(app, zone))
+				z.Applications++
+				usage[zone] = z
+			}
+		}
+		if next == nil {
+			break
+		}
+		pageToken = next
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for zone, capacity := range l.capacities {
+		z := usage[zone]
+		available := capacity.Sub(z.Used)
+		z.Capacity = &capacity
+		z.Available = &available
+		usage[zone] = z
+	}
+	return usage, nil
+}
+
+// Requirements returns the resources app reserves in zone, given the replicas it is scaled to.
+func Requirements(app model.Application, zone string) catalog.Resources {
+	return catalog.GetScaledRequirements(server.ApplicationService(app.Service), app.ReplicasIn(zone))
+}
+
+// Without returns usage without the requirements of app in its zones.
+func Without(usage map[string]Zone, app model.Application) map[string]Zone {
+	result := maps.Clone(usage)
+	for _, zone := range app.Zones {
+		z, ok := result[zone]
+		if !ok {
+			continue
+		}
+		z.Used = z.Used.Sub(Requirements(app, zone))
+		z.Applications--
+		if z.Capacity != nil {
+			available := z.Capacity.Sub(z.Used)
+			z.Available = &available
+		}
+		result[zone] = z
+	}
+	return result
+}
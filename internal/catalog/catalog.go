@@ -35,3 +35,63 @@ func GetContainerApp() *ContainerApp {
 		Replica: int32(2),
 	}
 }
+
+// Resources are CPU cores and GiB of RAM.
+type Resources struct {
+	Cpu int `json:"cpu" yaml:"cpu"`
+	Ram int `json:"ram" yaml:"ram"`
+}
+
+// Add returns the sum of r and other.
+func (r Resources) Add(other Resources) Resources {
+	return Resources{Cpu: r.Cpu + other.Cpu, Ram: r.Ram + other.Ram}
+}
+
+// Sub returns r minus other.
+func (r Resources) Sub(other Resources) Resources {
+	return Resources{Cpu: r.Cpu - other.Cpu, Ram: r.Ram - other.Ram}
+}
+
+// Fits reports whether other fits in r.
+func (r Resources) Fits(other Resources) bool {
+	return other.Cpu <= r.Cpu && other.Ram <= r.Ram
+}
+
+// Resources reserved by each replica of a container application
+const (
+	containerReplicaCpu = 1
+	containerReplicaRam = 1
+)
+
+// GetReplicas returns the number of instances an application of the service runs in each of its zones.
+func GetReplicas(serviceName server.ApplicationService) int {
+	return GetScaledReplicas(serviceName, 0)
+}
+
+// GetScaledReplicas returns the number of instances an application of the service scaled to
+// replicas runs in a zone, the catalog replicas when replicas is 0. Virtual machines are not
+// scaled.
+func GetScaledReplicas(serviceName server.ApplicationService, replicas int) int {
+	if GetCatalogVm(serviceName) != nil {
+		return 1
+	}
+	if replicas > 0 {
+		return replicas
+	}
+	return int(GetContainerApp().Replica)
+}
+
+// GetRequirements returns the resources an application of the service reserves in each of its zones.
+func GetRequirements(serviceName server.ApplicationService) Resources {
+	return GetScaledRequirements(serviceName, 0)
+}
+
+// GetScaledRequirements returns the resources an application of the service scaled to replicas
+// reserves in a zone, the catalog replicas when replicas is 0.
+func GetScaledRequirements(serviceName server.ApplicationService, replicas int) Resources {
+	if vm := GetCatalogVm(serviceName); vm != nil {
+		return Resources{Cpu: vm.Cpu, Ram: vm.Ram}
+	}
+	replicas = GetScaledReplicas(serviceName, replicas)
+	return Resources{Cpu: replicas * containerReplicaCpu, Ram: replicas * containerReplicaRam}
+}
@@ -1,14 +1,19 @@
 package config
 
 import (
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/httpclient"
 	"github.com/kelseyhightower/envconfig"
 )
 
 var singleConfig *Config = nil
 
 type Config struct {
-	Database *dbConfig
-	Service  *svcConfig
+	Database   *dbConfig
+	Service    *svcConfig
+	HTTPClient *httpClientConfig
+	Webhook    *webhookConfig
 }
 
 type dbConfig struct {
@@ -26,6 +31,61 @@ type svcConfig struct {
 	LogLevel           string `envconfig:"DCM_LOG_LEVEL" default:"info"`
 	OpaServer          string `envconfig:"DCM_OPA_SERVER" default:"http://localhost:8181"`
 	ProviderServiceUrl string `envconfig:"PROVIDER_SERVICE_URL" default:"http://localhost:8080/api/v1"`
+	ProvidersConfig    string `envconfig:"DCM_PROVIDERS_CONFIG"`
+	CapacityConfig     string `envconfig:"DCM_CAPACITY_CONFIG"`
+	Mode               string `envconfig:"DCM_MODE" default:"prod"`
+	// ZoneInventoryTTL is how long the namespaces listed by the providers are reused
+	ZoneInventoryTTL time.Duration `envconfig:"DCM_ZONE_INVENTORY_TTL" default:"30s"`
+	// ZoneSyncInterval is how often the zones table is synchronized with the providers
+	ZoneSyncInterval time.Duration `envconfig:"DCM_ZONE_SYNC_INTERVAL" default:"1m"`
+	// FailoverInterval is how often production deployments are checked for automatic failovers,
+	// disabled when 0
+	FailoverInterval time.Duration `envconfig:"DCM_FAILOVER_INTERVAL" default:"0s"`
+	// SimulationMaxApplications and SimulationTimeout bound the policy simulations
+	SimulationMaxApplications int           `envconfig:"DCM_SIMULATION_MAX_APPLICATIONS" default:"1000"`
+	SimulationTimeout         time.Duration `envconfig:"DCM_SIMULATION_TIMEOUT" default:"1m"`
+	// OpaExplain is the explanation stored with decisions: off, notes, fails or full
+	OpaExplain string `envconfig:"DCM_OPA_EXPLAIN" default:"off"`
+	// TrustedProxies are the addresses or CIDRs of the authenticating proxies whose
+	// X-Remote-User header identifies the caller, the header is ignored when empty
+	TrustedProxies []string `envconfig:"DCM_TRUSTED_PROXIES"`
+}
+
+// HTTPClientSettings returns the resilience settings of outbound HTTP clients.
+func (c *Config) HTTPClientSettings() httpclient.Config {
+	return httpclient.Config{
+		Timeout:          c.HTTPClient.Timeout,
+		MaxRetries:       c.HTTPClient.MaxRetries,
+		RetryBackoff:     c.HTTPClient.RetryBackoff,
+		RetryMaxBackoff:  c.HTTPClient.RetryMaxBackoff,
+		BreakerThreshold: c.HTTPClient.BreakerThreshold,
+		BreakerTimeout:   c.HTTPClient.BreakerTimeout,
+	}
+}
+
+const (
+	// ModeProd talks to the configured OPA server and providers
+	ModeProd = "prod"
+	// ModeDev replaces OPA, the providers and the database with in-process fakes
+	ModeDev = "dev"
+)
+
+type httpClientConfig struct {
+	Timeout          time.Duration `envconfig:"DCM_HTTP_TIMEOUT" default:"10s"`
+	MaxRetries       int           `envconfig:"DCM_HTTP_MAX_RETRIES" default:"3"`
+	RetryBackoff     time.Duration `envconfig:"DCM_HTTP_RETRY_BACKOFF" default:"200ms"`
+	RetryMaxBackoff  time.Duration `envconfig:"DCM_HTTP_RETRY_MAX_BACKOFF" default:"2s"`
+	BreakerThreshold int           `envconfig:"DCM_HTTP_BREAKER_THRESHOLD" default:"5"`
+	BreakerTimeout   time.Duration `envconfig:"DCM_HTTP_BREAKER_TIMEOUT" default:"30s"`
+}
+
+type webhookConfig struct {
+	Timeout      time.Duration `envconfig:"DCM_WEBHOOK_TIMEOUT" default:"10s"`
+	MaxAttempts  int           `envconfig:"DCM_WEBHOOK_MAX_ATTEMPTS" default:"5"`
+	RetryBackoff time.Duration `envconfig:"DCM_WEBHOOK_RETRY_BACKOFF" default:"1s"`
+	Workers      int           `envconfig:"DCM_WEBHOOK_WORKERS" default:"8"`
+	QueueSize    int           `envconfig:"DCM_WEBHOOK_QUEUE_SIZE" default:"1000"`
+	Source       string        `envconfig:"DCM_WEBHOOK_SOURCE" default:"/dcm/placement"`
 }
 
 func New() (*Config, error) {
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBatchItems bounds the size of a batch request
+	maxBatchItems = 100
+	// maxBatchConcurrency bounds the max_concurrency of a batch request
+	maxBatchConcurrency = 16
+)
+
+// (POST /applications:batchCreate)
+func (s *ServiceHandler) BatchCreateApplications(ctx context.Context, request server.BatchCreateApplicationsRequestObject) (server.BatchCreateApplicationsResponseObject, error) {
+	logger := zap.S().Named("placement_service")
+
+	body := request.Body
+	opts, err := batchOptions(len(body.Items), (*string)(body.Mode), body.MaxConcurrency)
+	if err != nil {
+		return server.BatchCreateApplications400JSONResponse{Error: err.Error()}, nil
+	}
+
+	items := make([]service.BatchCreateItem, 0, len(body.Items))
+	for _, item := range body.Items {
+		application := item.Application
+		batchItem := service.BatchCreateItem{Application: &application}
+		if item.Id != nil {
+			batchItem.ID = item.Id.String()
+		}
+		items = append(items, batchItem)
+	}
+
+	logger.Info("Creating Applications in bulk. ", "Items: ", len(items), " Atomic: ", opts.Atomic)
+	results := s.ps.BatchCreateApplications(ctx, items, opts)
+	return server.BatchCreateApplications200JSONResponse(batchResponse(results)), nil
+}
+
+// (POST /applications:batchDelete)
+func (s *ServiceHandler) BatchDeleteApplications(ctx context.Context, request server.BatchDeleteApplicationsRequestObject) (server.BatchDeleteApplicationsResponseObject, error) {
+	logger := zap.S().Named("placement_service")
+
+	body := request.Body
+	opts, err := batchOptions(len(body.Ids), (*string)(body.Mode), body.MaxConcurrency)
+	if err != nil {
+		return server.BatchDeleteApplications400JSONResponse{Error: err.Error()}, nil
+	}
+
+	logger.Info("Deleting Applications in bulk. ", "Items: ", len(body.Ids), " Atomic: ", opts.Atomic)
+	results := s.ps.BatchDeleteApplications(ctx, body.Ids, opts)
+	return server.BatchDeleteApplications200JSONResponse(batchResponse(results)), nil
+}
+
+func batchOptions(items int, mode *string, maxConcurrency *int) (service.BatchOptions, error) {
+	opts := service.BatchOptions{Concurrency: service.DefaultBatchConcurrency}
+	if items == 0 {
+		return opts, fmt.Errorf("the batch is empty")
+	}
+	if items > maxBatchItems {
+		return opts, fmt.Errorf("the batch has %d items, at most %d are allowed", items, maxBatchItems)
+	}
+	if mode != nil {
+		switch server.BatchCreateRequestMode(*mode) {
+		case server.BatchCreateRequestModeAtomic:
+			opts.Atomic = true
+		case server.BatchCreateRequestModeIndependent:
+		default:
+			return opts, fmt.Errorf("unknown mode %q", *mode)
+		}
+	}
+	if maxConcurrency != nil {
+		if *maxConcurrency < 1 || *maxConcurrency > maxBatchConcurrency {
+			return opts, fmt.Errorf("max_concurrency must be between 1 and %d", maxBatchConcurrency)
+		}
+		opts.Concurrency = *maxConcurrency
+	}
+	return opts, nil
+}
+
+func batchResponse(results []service.BatchResult) server.BatchResponse {
+	response := server.BatchResponse{Results: make([]server.BatchItemResult, 0, len(results))}
+	for i, r := range results {
+		item := server.BatchItemResult{
+			Index:       i,
+			Status:      server.BatchItemResultStatus(r.Status),
+			Application: r.Application,
+		}
+		if r.ID != uuid.Nil {
+			id := r.ID
+			item.Id = &id
+		}
+		if r.Err != nil {
+			message := r.Err.Error()
+			item.Error = &message
+		}
+		if r.Status == service.BatchItemSucceeded {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response
+}
@@ -2,12 +2,17 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/dcm-project/dcm-placement-api/internal/api/server"
 	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/httpclient"
 	"github.com/dcm-project/dcm-placement-api/internal/service"
 	"github.com/dcm-project/dcm-placement-api/internal/store"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type ServiceHandler struct {
@@ -26,15 +31,37 @@ func NewServiceHandler(store store.Store, placementService *service.PlacementSer
 func (s *ServiceHandler) GetHealth(ctx context.Context, request server.GetHealthRequestObject) (server.GetHealthResponseObject, error) {
 	status := "healthy"
 	path := "/health"
+	breakers := []server.CircuitBreaker{}
+	for _, b := range httpclient.Breakers() {
+		failures := b.Failures
+		breakers = append(breakers, server.CircuitBreaker{
+			Name:     b.Name,
+			State:    server.CircuitBreakerState(b.State),
+			Failures: &failures,
+		})
+		// A dependency being unreachable degrades the service without making it unhealthy
+		if b.State != httpclient.BreakerClosed {
+			status = "degraded"
+		}
+	}
 	return server.GetHealth200JSONResponse{
-		Status: &status,
-		Path:   &path,
+		Status:          &status,
+		Path:            &path,
+		CircuitBreakers: &breakers,
 	}, nil
 }
 
 // (GET /applications)
 func (s *ServiceHandler) ListApplications(ctx context.Context, request server.ListApplicationsRequestObject) (server.ListApplicationsResponseObject, error) {
-	applications, nextPageToken, err := s.store.Application().List(ctx, request.Params.MaxPageSize, request.Params.PageToken)
+	filter := store.ApplicationFilter{}
+	if request.Params.LabelSelector != nil {
+		labels, err := service.ParseLabelSelector(*request.Params.LabelSelector)
+		if err != nil {
+			return server.ListApplications400JSONResponse{Error: err.Error()}, nil
+		}
+		filter.Labels = labels
+	}
+	applications, nextPageToken, err := s.store.Application().List(ctx, filter, request.Params.MaxPageSize, request.Params.PageToken)
 	if err != nil {
 		return server.ListApplications400JSONResponse{}, nil
 	}
@@ -43,6 +70,30 @@ func (s *ServiceHandler) ListApplications(ctx context.Context, request server.Li
 	return server.ListApplications200JSONResponse(response), nil
 }
 
+// (GET /applications/{id})
+func (s *ServiceHandler) GetApplication(ctx context.Context, request server.GetApplicationRequestObject) (server.GetApplicationResponseObject, error) {
+	app, err := s.store.Application().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetApplication404JSONResponse{Error: fmt.Sprintf("application %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetApplication500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetApplication200JSONResponse(*mappers.ApplicationToAPI(*app)), nil
+}
+
+// (GET /applications/{id}/placement-decision)
+func (s *ServiceHandler) GetPlacementDecision(ctx context.Context, request server.GetPlacementDecisionRequestObject) (server.GetPlacementDecisionResponseObject, error) {
+	decision, err := s.store.Decision().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetPlacementDecision404JSONResponse{Error: fmt.Sprintf("no placement decision for application %s", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetPlacementDecision500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetPlacementDecision200JSONResponse(mappers.PlacementDecisionToAPI(*decision)), nil
+}
+
 // (DELETE /applications/{id})
 func (s *ServiceHandler) DeleteApplication(ctx context.Context, request server.DeleteApplicationRequestObject) (server.DeleteApplicationResponseObject, error) {
 	logger := zap.S().Named("placement_service")
@@ -57,16 +108,107 @@ func (s *ServiceHandler) DeleteApplication(ctx context.Context, request server.D
 	return server.DeleteApplication204JSONResponse(*app), nil
 }
 
+// (PUT /applications/{id})
+func (s *ServiceHandler) ReplaceApplication(ctx context.Context, request server.ReplaceApplicationRequestObject) (server.ReplaceApplicationResponseObject, error) {
+	logger := zap.S().Named("placement_service")
+	logger.Info("Replacing Application. ", "Application: ", request.Id)
+
+	app, err := s.ps.ReplaceApplication(ctx, request.Id, request.Body)
+	var quotaExceeded *service.QuotaExceededError
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return server.ReplaceApplication404JSONResponse{Error: fmt.Sprintf("application %s not found", request.Id)}, nil
+	case errors.As(err, &quotaExceeded):
+		logger.Info("Application exceeds a quota: ", "error", err)
+		return server.ReplaceApplication403JSONResponse{Error: err.Error()}, nil
+	case err != nil:
+		logger.Error("Failed to replace Application: ", "error", err)
+		return server.ReplaceApplication400JSONResponse{Error: err.Error()}, nil
+	}
+	logger.Info("Application replaced. ", "Application: ", request.Id)
+	return server.ReplaceApplication200JSONResponse(*app), nil
+}
+
+// (POST /applications/{id}:failover)
+func (s *ServiceHandler) FailoverApplication(ctx context.Context, request server.FailoverApplicationRequestObject) (server.FailoverApplicationResponseObject, error) {
+	var opts service.FailoverOptions
+	if request.Body != nil {
+		if request.Body.Target != nil {
+			opts.Target = string(*request.Body.Target)
+		}
+		if request.Body.Reason != nil {
+			opts.Reason = *request.Body.Reason
+		}
+	}
+	app, err := s.ps.Failover(ctx, request.Id, opts)
+	var conflict *service.FailoverConflictError
+	var rejected *service.PolicyRejectedError
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return server.FailoverApplication404JSONResponse{Error: fmt.Sprintf("application %s not found", request.Id)}, nil
+	case errors.As(err, &conflict):
+		return server.FailoverApplication409JSONResponse{Error: err.Error()}, nil
+	case errors.As(err, &rejected), errors.Is(err, service.ErrInvalidFailoverTarget):
+		return server.FailoverApplication400JSONResponse{Error: err.Error()}, nil
+	case err != nil:
+		zap.S().Named("placement_service").Error("Failed to fail Application over: ", "error", err)
+		return server.FailoverApplication500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.FailoverApplication200JSONResponse(*app), nil
+}
+
+// (POST /applications/{id}:scale)
+func (s *ServiceHandler) ScaleApplication(ctx context.Context, request server.ScaleApplicationRequestObject) (server.ScaleApplicationResponseObject, error) {
+	var opts service.ScaleOptions
+	if request.Body.Replicas != nil {
+		opts.Replicas = *request.Body.Replicas
+	}
+	if request.Body.Zones != nil {
+		opts.ZoneReplicas = *request.Body.Zones
+	}
+	app, err := s.ps.ScaleApplication(ctx, request.Id, opts)
+	var invalid *service.InvalidScaleError
+	var rejected *service.PolicyRejectedError
+	var quotaExceeded *service.QuotaExceededError
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return server.ScaleApplication404JSONResponse{Error: fmt.Sprintf("application %s not found", request.Id)}, nil
+	case errors.As(err, &invalid), errors.As(err, &rejected):
+		return server.ScaleApplication400JSONResponse{Error: err.Error()}, nil
+	case errors.As(err, &quotaExceeded):
+		return server.ScaleApplication403JSONResponse{Error: err.Error()}, nil
+	case err != nil:
+		zap.S().Named("placement_service").Error("Failed to scale Application: ", "error", err)
+		return server.ScaleApplication500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.ScaleApplication200JSONResponse(*app), nil
+}
+
 // (POST /applications)
 func (s *ServiceHandler) CreateApplication(ctx context.Context, request server.CreateApplicationRequestObject) (server.CreateApplicationResponseObject, error) {
 	logger := zap.S().Named("placement_service")
 	logger.Info("Creating Application. ", "Application: ", request)
 
 	paramId := ""
-	if (request.Params != server.CreateApplicationParams{}) {
+	if request.Params.Id != nil {
 		paramId = *request.Params.Id
 	}
-	app, err := s.ps.CreateApplication(ctx, request.Body, paramId)
+	opts := service.CreateOptions{RollbackOnFailure: true}
+	if request.Params.WaitForReady != nil {
+		opts.WaitForReady = *request.Params.WaitForReady
+	}
+	if request.Params.ReadyTimeout != nil {
+		opts.ReadyTimeout = time.Duration(*request.Params.ReadyTimeout) * time.Second
+	}
+	if request.Params.RollbackOnFailure != nil {
+		opts.RollbackOnFailure = *request.Params.RollbackOnFailure
+	}
+	app, err := s.ps.CreateApplication(ctx, request.Body, paramId, opts)
+	var quotaExceeded *service.QuotaExceededError
+	if errors.As(err, &quotaExceeded) {
+		logger.Info("Application exceeds a quota: ", "error", err)
+		return server.CreateApplication403JSONResponse{Error: err.Error()}, nil
+	}
 	if err != nil {
 		logger.Error("Failed to create Application: ", "error", err)
 		return server.CreateApplication400JSONResponse{Error: err.Error()}, nil
@@ -74,3 +216,54 @@ func (s *ServiceHandler) CreateApplication(ctx context.Context, request server.C
 	logger.Info("Application created. ", "Application: ", app)
 	return server.CreateApplication201JSONResponse(*app), nil
 }
+
+// (POST /applications:preview)
+func (s *ServiceHandler) PreviewApplication(ctx context.Context, request server.PreviewApplicationRequestObject) (server.PreviewApplicationResponseObject, error) {
+	preview, err := s.ps.PreviewPlacement(ctx, request.Body)
+	if err != nil {
+		zap.S().Named("placement_service").Error("Failed to preview Application: ", "error", err)
+		return server.PreviewApplication400JSONResponse{Error: err.Error()}, nil
+	}
+	return server.PreviewApplication200JSONResponse(*preview), nil
+}
+
+// (GET /applications/{id}/events)
+func (s *ServiceHandler) ListApplicationEvents(ctx context.Context, request server.ListApplicationEventsRequestObject) (server.ListApplicationEventsResponseObject, error) {
+	filter := store.EventFilter{ApplicationID: &request.Id}
+	if request.Params.Type != nil {
+		filter.Type = *request.Params.Type
+	}
+	events, nextPageToken, err := s.store.Event().List(ctx, filter, request.Params.MaxPageSize, request.Params.PageToken)
+	if err != nil {
+		return server.ListApplicationEvents500JSONResponse{Error: err.Error()}, nil
+	}
+	response := mappers.EventListToAPI(events)
+	response.NextPageToken = nextPageToken
+	return server.ListApplicationEvents200JSONResponse(response), nil
+}
+
+// (GET /events)
+func (s *ServiceHandler) ListEvents(ctx context.Context, request server.ListEventsRequestObject) (server.ListEventsResponseObject, error) {
+	params := request.Params
+	filter := store.EventFilter{
+		ApplicationID: params.ApplicationId,
+		Since:         params.Since,
+		Until:         params.Until,
+	}
+	if params.Type != nil {
+		filter.Type = *params.Type
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.RequestId != nil {
+		filter.RequestID = *params.RequestId
+	}
+	events, nextPageToken, err := s.store.Event().List(ctx, filter, params.MaxPageSize, params.PageToken)
+	if err != nil {
+		return server.ListEvents500JSONResponse{Error: err.Error()}, nil
+	}
+	response := mappers.EventListToAPI(events)
+	response.NextPageToken = nextPageToken
+	return server.ListEvents200JSONResponse(response), nil
+}
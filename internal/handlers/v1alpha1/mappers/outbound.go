@@ -4,22 +4,113 @@ import (
 	"fmt"
 
 	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
 	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
 )
 
 func ApplicationToAPI(dbApp model.Application) *server.ApplicationResponse {
 	zones := []string(dbApp.Zones)
 	path := fmt.Sprintf("applications/%s", dbApp.ID)
+	environment := server.ApplicationResponseEnvironment(dbApp.InEnvironment())
+	replicas := catalog.GetScaledReplicas(server.ApplicationService(dbApp.Service), dbApp.Replicas)
 	return &server.ApplicationResponse{
-		Path:    &path,
-		Name:    &dbApp.Name,
-		Service: &dbApp.Service,
-		Tier:    &dbApp.Tier,
-		Zones:   &zones,
-		Id:      &dbApp.ID,
+		Path:          &path,
+		Name:          &dbApp.Name,
+		Service:       &dbApp.Service,
+		Tier:          &dbApp.Tier,
+		Tenant:        optionalString(dbApp.Tenant),
+		Zones:         &zones,
+		Id:            &dbApp.ID,
+		Status:        optionalString(dbApp.Status),
+		StatusMessage: optionalString(dbApp.StatusMessage),
+		Placement:     optionalSelections(ZoneSelectionsToAPI(dbApp.Placement)),
+		Labels:        optionalLabels(dbApp.Labels),
+		Annotations:   optionalLabels(dbApp.Annotations),
+		Affinity:      SelectorToAPI(dbApp.Affinity),
+		AntiAffinity:  SelectorToAPI(dbApp.AntiAffinity),
+		Environment:   &environment,
+		Failovers:     FailoversToAPI(dbApp.Failovers),
+		Replicas:      &replicas,
+		ZoneReplicas:  optionalReplicas(dbApp.ZoneReplicas),
 	}
 }
 
+func FailoversToAPI(failovers []model.Failover) *[]server.ApplicationFailover {
+	if len(failovers) == 0 {
+		return nil
+	}
+	result := make([]server.ApplicationFailover, 0, len(failovers))
+	for _, f := range failovers {
+		fromZones, toZones := f.FromZones, f.ToZones
+		result = append(result, server.ApplicationFailover{
+			From:      f.From,
+			To:        f.To,
+			FromZones: &fromZones,
+			ToZones:   toZones,
+			Reason:    optionalString(f.Reason),
+			Automatic: f.Automatic,
+			At:        f.At,
+		})
+	}
+	return &result
+}
+
+func SelectorToAPI(selector *model.ApplicationSelector) *server.ApplicationSelector {
+	if selector == nil {
+		return nil
+	}
+	result := &server.ApplicationSelector{}
+	if len(selector.ApplicationIDs) > 0 {
+		ids := append([]uuid.UUID{}, selector.ApplicationIDs...)
+		result.ApplicationIds = &ids
+	}
+	result.MatchLabels = optionalLabels(selector.MatchLabels)
+	return result
+}
+
+func optionalReplicas(replicas map[string]int) *map[string]int {
+	if len(replicas) == 0 {
+		return nil
+	}
+	return &replicas
+}
+
+func optionalLabels(labels map[string]string) *map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	return &labels
+}
+
+func ZoneSelectionsToAPI(selections []model.ZoneSelection) []server.ZoneSelection {
+	result := make([]server.ZoneSelection, 0, len(selections))
+	for _, s := range selections {
+		result = append(result, server.ZoneSelection{
+			Zone:     s.Zone,
+			Region:   optionalString(s.Region),
+			Score:    s.Score,
+			Selected: s.Selected,
+			Reason:   s.Reason,
+		})
+	}
+	return result
+}
+
+func optionalSelections(selections []server.ZoneSelection) *[]server.ZoneSelection {
+	if len(selections) == 0 {
+		return nil
+	}
+	return &selections
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func ApplicationListToAPI(dbApps model.ApplicationList) server.ApplicationList {
 	var apiApps []server.ApplicationResponse
 	for _, dbApp := range dbApps {
@@ -27,3 +118,155 @@ func ApplicationListToAPI(dbApps model.ApplicationList) server.ApplicationList {
 	}
 	return server.ApplicationList{Applications: apiApps}
 }
+
+func EventToAPI(dbEvent model.Event) server.Event {
+	event := server.Event{
+		Id:           int(dbEvent.ID),
+		Type:         dbEvent.Type,
+		CreatedAt:    dbEvent.CreatedAt,
+		Message:      optionalString(dbEvent.Message),
+		Actor:        optionalString(dbEvent.Actor),
+		RequestId:    optionalString(dbEvent.RequestID),
+		Zone:         optionalString(dbEvent.Zone),
+		Provider:     optionalString(dbEvent.Provider),
+		DeploymentId: optionalString(dbEvent.DeploymentID),
+	}
+	if dbEvent.ApplicationID != uuid.Nil {
+		appID := dbEvent.ApplicationID
+		event.ApplicationId = &appID
+	}
+	return event
+}
+
+func EventListToAPI(dbEvents model.EventList) server.EventList {
+	apiEvents := make([]server.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		apiEvents = append(apiEvents, EventToAPI(dbEvent))
+	}
+	return server.EventList{Events: apiEvents}
+}
+
+// SubscriptionToAPI maps a subscription without its secret.
+func SubscriptionToAPI(dbSubscription model.Subscription) server.Subscription {
+	eventTypes := append([]string{}, dbSubscription.EventTypes...)
+	return server.Subscription{
+		Id:         &dbSubscription.ID,
+		Url:        dbSubscription.URL,
+		EventTypes: &eventTypes,
+		CreatedAt:  &dbSubscription.CreatedAt,
+	}
+}
+
+func SubscriptionListToAPI(dbSubscriptions model.SubscriptionList) server.SubscriptionList {
+	apiSubscriptions := make([]server.Subscription, 0, len(dbSubscriptions))
+	for _, dbSubscription := range dbSubscriptions {
+		apiSubscriptions = append(apiSubscriptions, SubscriptionToAPI(dbSubscription))
+	}
+	return server.SubscriptionList{Subscriptions: apiSubscriptions}
+}
+
+func DeadLetterListToAPI(dbDeadLetters model.DeadLetterList) server.DeadLetterList {
+	apiDeadLetters := make([]server.DeadLetter, 0, len(dbDeadLetters))
+	for _, dl := range dbDeadLetters {
+		attempts := dl.Attempts
+		createdAt := dl.CreatedAt
+		apiDeadLetters = append(apiDeadLetters, server.DeadLetter{
+			Id:        int(dl.ID),
+			EventId:   dl.EventID,
+			EventType: dl.EventType,
+			Payload:   optionalString(dl.Payload),
+			Attempts:  &attempts,
+			LastError: optionalString(dl.LastError),
+			CreatedAt: &createdAt,
+		})
+	}
+	return server.DeadLetterList{DeadLetters: apiDeadLetters}
+}
+
+func QuotaToAPI(dbQuota model.Quota) server.Quota {
+	return server.Quota{
+		Id:              &dbQuota.ID,
+		Tenant:          optionalString(dbQuota.Tenant),
+		Tier:            &dbQuota.Tier,
+		MaxApplications: dbQuota.MaxApplications,
+		MaxCpu:          dbQuota.MaxCpu,
+		MaxRam:          dbQuota.MaxRam,
+		MaxReplicas:     dbQuota.MaxReplicas,
+		CreatedAt:       &dbQuota.CreatedAt,
+	}
+}
+
+func TierToAPI(dbTier model.Tier) server.Tier {
+	tier := server.Tier{
+		Id:          dbTier.ID,
+		PolicyPath:  dbTier.PolicyPath,
+		Default:     &dbTier.Default,
+		Description: optionalString(dbTier.Description),
+		CreatedAt:   &dbTier.CreatedAt,
+	}
+	if len(dbTier.Services) > 0 {
+		services := append([]string{}, dbTier.Services...)
+		tier.Services = &services
+	}
+	return tier
+}
+
+func PlacementDecisionToAPI(dbDecision model.PlacementDecision) server.PlacementDecision {
+	decision := server.PlacementDecision{
+		ApplicationId: dbDecision.ApplicationID,
+		Tier:          dbDecision.Tier,
+		Policy:        dbDecision.Policy,
+		DecisionId:    optionalString(dbDecision.DecisionID),
+		Revision:      optionalString(dbDecision.Revision),
+		Input:         dbDecision.Input,
+		Result:        dbDecision.Result,
+		CreatedAt:     &dbDecision.CreatedAt,
+	}
+	if len(dbDecision.Explanation) > 0 {
+		explanation := dbDecision.Explanation
+		decision.Explanation = &explanation
+	}
+	return decision
+}
+
+func ZoneToAPI(dbZone model.Zone) server.Zone {
+	return server.Zone{
+		Id:          dbZone.ID,
+		Provider:    dbZone.Provider,
+		Region:      optionalString(dbZone.Region),
+		Environment: optionalString(dbZone.Environment),
+		Labels:      optionalLabels(dbZone.Labels),
+		Healthy:     dbZone.Healthy,
+		Cordoned:    dbZone.Cordoned,
+		LastSeenAt:  dbZone.LastSeenAt,
+		CreatedAt:   &dbZone.CreatedAt,
+	}
+}
+
+func ZoneDrainToAPI(dbDrain model.Drain) server.ZoneDrain {
+	drain := server.ZoneDrain{
+		Id:           dbDrain.ID,
+		Zone:         dbDrain.ZoneID,
+		Status:       server.ZoneDrainStatus(dbDrain.Status),
+		Total:        len(dbDrain.Applications),
+		Evacuated:    dbDrain.Evacuated,
+		Failed:       dbDrain.Failed,
+		Applications: make([]server.ZoneDrainApplication, 0, len(dbDrain.Applications)),
+		CreatedAt:    &dbDrain.CreatedAt,
+		CompletedAt:  dbDrain.CompletedAt,
+	}
+	for _, item := range dbDrain.Applications {
+		application := server.ZoneDrainApplication{
+			ApplicationId: item.ApplicationID,
+			Name:          item.Name,
+			Status:        server.ZoneDrainApplicationStatus(item.Status),
+			Error:         optionalString(item.Error),
+		}
+		if len(item.Zones) > 0 {
+			zones := item.Zones
+			application.Zones = &zones
+		}
+		drain.Applications = append(drain.Applications, application)
+	}
+	return drain
+}
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"go.uber.org/zap"
+)
+
+// (POST /policies:simulate)
+func (s *ServiceHandler) SimulatePolicies(ctx context.Context, request server.SimulatePoliciesRequestObject) (server.SimulatePoliciesResponseObject, error) {
+	bundle := &opa.Bundle{Modules: request.Body.Modules}
+	if request.Body.Data != nil {
+		bundle.Data = *request.Body.Data
+	}
+	engine, err := opa.NewEmbeddedEngine(bundle)
+	if err != nil {
+		return server.SimulatePolicies400JSONResponse{Error: err.Error()}, nil
+	}
+
+	simulations, err := s.ps.Simulate(ctx, engine)
+	var tooMany *service.SimulationLimitError
+	if errors.As(err, &tooMany) {
+		return server.SimulatePolicies400JSONResponse{Error: err.Error()}, nil
+	}
+	if err != nil {
+		return server.SimulatePolicies500JSONResponse{Error: err.Error()}, nil
+	}
+	response := server.PolicySimulation{Applications: make([]server.ApplicationSimulation, 0, len(simulations))}
+	for _, simulation := range simulations {
+		switch simulation.Outcome {
+		case service.SimulationUnchanged:
+			response.Unchanged++
+		case service.SimulationMoved:
+			response.Moved++
+		case service.SimulationInvalid:
+			response.Invalid++
+		case service.SimulationError:
+			response.Errors++
+		}
+		response.Applications = append(response.Applications, applicationSimulationToAPI(simulation))
+	}
+	zap.S().Named("placement_service").Info("Policies simulated. ", "Unchanged: ", response.Unchanged, " Moved: ", response.Moved,
+		" Invalid: ", response.Invalid, " Errors: ", response.Errors)
+	return server.SimulatePolicies200JSONResponse(response), nil
+}
+
+func applicationSimulationToAPI(simulation service.ApplicationSimulation) server.ApplicationSimulation {
+	app := simulation.Application
+	result := server.ApplicationSimulation{
+		Id:           app.ID,
+		Name:         app.Name,
+		Tier:         app.Tier,
+		Outcome:      server.ApplicationSimulationOutcome(simulation.Outcome),
+		CurrentZones: append([]string{}, app.Zones...),
+	}
+	if app.Tenant != "" {
+		tenant := app.Tenant
+		result.Tenant = &tenant
+	}
+	if simulation.SimulatedZones != nil {
+		zones := simulation.SimulatedZones
+		result.SimulatedZones = &zones
+	}
+	if simulation.Reason != "" {
+		reason := simulation.Reason
+		result.Reason = &reason
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// (GET /quotas)
+func (s *ServiceHandler) ListQuotas(ctx context.Context, request server.ListQuotasRequestObject) (server.ListQuotasResponseObject, error) {
+	quotas, err := s.store.Quota().List(ctx)
+	if err != nil {
+		return server.ListQuotas500JSONResponse{Error: err.Error()}, nil
+	}
+	response, err := s.quotasToAPI(ctx, quotas...)
+	if err != nil {
+		return server.ListQuotas500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.ListQuotas200JSONResponse{Quotas: response}, nil
+}
+
+// (POST /quotas)
+func (s *ServiceHandler) CreateQuota(ctx context.Context, request server.CreateQuotaRequestObject) (server.CreateQuotaResponseObject, error) {
+	body := request.Body
+	if err := validateQuota(body); err != nil {
+		return server.CreateQuota400JSONResponse{Error: err.Error()}, nil
+	}
+	quota := model.Quota{
+		ID:              uuid.New(),
+		MaxApplications: body.MaxApplications,
+		MaxCpu:          body.MaxCpu,
+		MaxRam:          body.MaxRam,
+		MaxReplicas:     body.MaxReplicas,
+	}
+	if body.Tenant != nil {
+		quota.Tenant = *body.Tenant
+	}
+	if body.Tier != nil {
+		quota.Tier = *body.Tier
+	}
+
+	created, err := s.store.Quota().Create(ctx, quota)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return server.CreateQuota409JSONResponse{Error: "a quota already exists for this tenant and tier"}, nil
+	}
+	if err != nil {
+		return server.CreateQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	zap.S().Named("placement_service").Info("Quota created. ", "Quota: ", created.ID)
+
+	response, err := s.quotasToAPI(ctx, *created)
+	if err != nil {
+		return server.CreateQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.CreateQuota201JSONResponse(response[0]), nil
+}
+
+// (GET /quotas/{id})
+func (s *ServiceHandler) GetQuota(ctx context.Context, request server.GetQuotaRequestObject) (server.GetQuotaResponseObject, error) {
+	quota, err := s.store.Quota().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetQuota404JSONResponse{Error: fmt.Sprintf("quota %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	response, err := s.quotasToAPI(ctx, *quota)
+	if err != nil {
+		return server.GetQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetQuota200JSONResponse(response[0]), nil
+}
+
+// (PUT /quotas/{id})
+func (s *ServiceHandler) UpdateQuota(ctx context.Context, request server.UpdateQuotaRequestObject) (server.UpdateQuotaResponseObject, error) {
+	body := request.Body
+	if err := validateQuota(body); err != nil {
+		return server.UpdateQuota400JSONResponse{Error: err.Error()}, nil
+	}
+	existing, err := s.store.Quota().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.UpdateQuota404JSONResponse{Error: fmt.Sprintf("quota %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.UpdateQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	if (body.Tenant != nil && *body.Tenant != existing.Tenant) || (body.Tier != nil && *body.Tier != existing.Tier) {
+		return server.UpdateQuota400JSONResponse{Error: "the tenant and tier of a quota cannot change"}, nil
+	}
+
+	existing.MaxApplications = body.MaxApplications
+	existing.MaxCpu = body.MaxCpu
+	existing.MaxRam = body.MaxRam
+	existing.MaxReplicas = body.MaxReplicas
+	updated, err := s.store.Quota().Update(ctx, *existing)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.UpdateQuota404JSONResponse{Error: fmt.Sprintf("quota %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.UpdateQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	response, err := s.quotasToAPI(ctx, *updated)
+	if err != nil {
+		return server.UpdateQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.UpdateQuota200JSONResponse(response[0]), nil
+}
+
+// (DELETE /quotas/{id})
+func (s *ServiceHandler) DeleteQuota(ctx context.Context, request server.DeleteQuotaRequestObject) (server.DeleteQuotaResponseObject, error) {
+	err := s.store.Quota().Delete(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.DeleteQuota404JSONResponse{Error: fmt.Sprintf("quota %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.DeleteQuota500JSONResponse{Error: err.Error()}, nil
+	}
+	zap.S().Named("placement_service").Info("Quota deleted. ", "Quota: ", request.Id)
+	return server.DeleteQuota204Response{}, nil
+}
+
+// quotasToAPI maps the quotas along with their current usage.
+func (s *ServiceHandler) quotasToAPI(ctx context.Context, quotas ...model.Quota) ([]server.Quota, error) {
+	usage, err := s.ps.QuotaUsage(ctx, quotas)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]server.Quota, 0, len(quotas))
+	for i, quota := range quotas {
+		q := mappers.QuotaToAPI(quota)
+		q.Usage = &server.QuotaUsage{
+			Applications: usage[i].Applications,
+			Cpu:          usage[i].Cpu,
+			Ram:          usage[i].Ram,
+			Replicas:     usage[i].Replicas,
+		}
+		response = append(response, q)
+	}
+	return response, nil
+}
+
+func validateQuota(quota *server.Quota) error {
+	if quota.Tier != nil && *quota.Tier < 0 {
+		return fmt.Errorf("tier must not be negative")
+	}
+	limits := map[string]*int{
+		"max_applications": quota.MaxApplications,
+		"max_cpu":          quota.MaxCpu,
+		"max_ram":          quota.MaxRam,
+		"max_replicas":     quota.MaxReplicas,
+	}
+	for name, limit := range limits {
+		if limit != nil && *limit < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/dcm-project/dcm-placement-api/internal/webhook"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// (POST /subscriptions)
+func (s *ServiceHandler) CreateSubscription(ctx context.Context, request server.CreateSubscriptionRequestObject) (server.CreateSubscriptionResponseObject, error) {
+	logger := zap.S().Named("placement_service")
+
+	body := request.Body
+	if err := validateSubscription(ctx, body); err != nil {
+		return server.CreateSubscription400JSONResponse{Error: err.Error()}, nil
+	}
+
+	secret := ""
+	if body.Secret != nil {
+		secret = *body.Secret
+	}
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return server.CreateSubscription500JSONResponse{Error: err.Error()}, nil
+		}
+		secret = hex.EncodeToString(buf)
+	}
+	var eventTypes []string
+	if body.EventTypes != nil {
+		eventTypes = *body.EventTypes
+	}
+
+	subscription, err := s.store.Subscription().Create(ctx, model.Subscription{
+		ID:         uuid.New(),
+		URL:        body.Url,
+		EventTypes: eventTypes,
+		Secret:     secret,
+	})
+	if err != nil {
+		logger.Error("Failed to create Subscription: ", "error", err)
+		return server.CreateSubscription500JSONResponse{Error: err.Error()}, nil
+	}
+	logger.Info("Subscription created. ", "Subscription: ", subscription.ID, " URL: ", subscription.URL)
+
+	// The secret is only disclosed once
+	response := mappers.SubscriptionToAPI(*subscription)
+	response.Secret = &subscription.Secret
+	return server.CreateSubscription201JSONResponse(response), nil
+}
+
+// (GET /subscriptions)
+func (s *ServiceHandler) ListSubscriptions(ctx context.Context, request server.ListSubscriptionsRequestObject) (server.ListSubscriptionsResponseObject, error) {
+	subscriptions, err := s.store.Subscription().List(ctx)
+	if err != nil {
+		return server.ListSubscriptions500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.ListSubscriptions200JSONResponse(mappers.SubscriptionListToAPI(subscriptions)), nil
+}
+
+// (GET /subscriptions/{id})
+func (s *ServiceHandler) GetSubscription(ctx context.Context, request server.GetSubscriptionRequestObject) (server.GetSubscriptionResponseObject, error) {
+	subscription, err := s.store.Subscription().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetSubscription404JSONResponse{Error: fmt.Sprintf("subscription %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetSubscription500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetSubscription200JSONResponse(mappers.SubscriptionToAPI(*subscription)), nil
+}
+
+// (DELETE /subscriptions/{id})
+func (s *ServiceHandler) DeleteSubscription(ctx context.Context, request server.DeleteSubscriptionRequestObject) (server.DeleteSubscriptionResponseObject, error) {
+	err := s.store.Subscription().Delete(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.DeleteSubscription404JSONResponse{Error: fmt.Sprintf("subscription %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.DeleteSubscription500JSONResponse{Error: err.Error()}, nil
+	}
+	zap.S().Named("placement_service").Info("Subscription deleted. ", "Subscription: ", request.Id)
+	return server.DeleteSubscription204Response{}, nil
+}
+
+// (GET /subscriptions/{id}/dead-letters)
+func (s *ServiceHandler) ListSubscriptionDeadLetters(ctx context.Context, request server.ListSubscriptionDeadLettersRequestObject) (server.ListSubscriptionDeadLettersResponseObject, error) {
+	if _, err := s.store.Subscription().Get(ctx, request.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return server.ListSubscriptionDeadLetters404JSONResponse{Error: fmt.Sprintf("subscription %s not found", request.Id)}, nil
+		}
+		return server.ListSubscriptionDeadLetters500JSONResponse{Error: err.Error()}, nil
+	}
+	deadLetters, err := s.store.Subscription().ListDeadLetters(ctx, request.Id)
+	if err != nil {
+		return server.ListSubscriptionDeadLetters500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.ListSubscriptionDeadLetters200JSONResponse(mappers.DeadLetterListToAPI(deadLetters)), nil
+}
+
+func validateSubscription(ctx context.Context, subscription *server.Subscription) error {
+	u, err := url.Parse(subscription.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if err := webhook.CheckURL(ctx, subscription.Url); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if subscription.EventTypes != nil {
+		for _, eventType := range *subscription.EventTypes {
+			if !slices.Contains(service.NotificationTypes, eventType) {
+				return fmt.Errorf("unknown event type %q, expected one of %v", eventType, service.NotificationTypes)
+			}
+		}
+	}
+	return nil
+}
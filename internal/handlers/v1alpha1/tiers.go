@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// (GET /tiers)
+func (s *ServiceHandler) ListTiers(ctx context.Context, request server.ListTiersRequestObject) (server.ListTiersResponseObject, error) {
+	tiers, err := s.store.Tier().List(ctx)
+	if err != nil {
+		return server.ListTiers500JSONResponse{Error: err.Error()}, nil
+	}
+	response := make([]server.Tier, 0, len(tiers))
+	for _, tier := range tiers {
+		response = append(response, mappers.TierToAPI(tier))
+	}
+	return server.ListTiers200JSONResponse{Tiers: response}, nil
+}
+
+// (POST /tiers)
+func (s *ServiceHandler) CreateTier(ctx context.Context, request server.CreateTierRequestObject) (server.CreateTierResponseObject, error) {
+	body := request.Body
+	tier := model.Tier{ID: body.Id, PolicyPath: body.PolicyPath}
+	if body.Default != nil {
+		tier.Default = *body.Default
+	}
+	if body.Description != nil {
+		tier.Description = *body.Description
+	}
+	if body.Services != nil {
+		tier.Services = *body.Services
+	}
+
+	created, err := s.ps.CreateTier(ctx, tier)
+	var invalid *service.InvalidTierError
+	if errors.As(err, &invalid) {
+		return server.CreateTier400JSONResponse{Error: err.Error()}, nil
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return server.CreateTier409JSONResponse{Error: fmt.Sprintf("tier %d is already registered", body.Id)}, nil
+	}
+	if err != nil {
+		return server.CreateTier500JSONResponse{Error: err.Error()}, nil
+	}
+	zap.S().Named("placement_service").Info("Tier registered. ", "Tier: ", created.ID, " Policy: ", created.PolicyPath)
+	return server.CreateTier201JSONResponse(mappers.TierToAPI(*created)), nil
+}
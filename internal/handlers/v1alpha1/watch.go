@@ -0,0 +1,111 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"go.uber.org/zap"
+)
+
+// watchKeepAlive is the interval of the comments keeping idle streams open through proxies.
+const watchKeepAlive = 15 * time.Second
+
+// lastEventIDHeader is sent by EventSource clients reconnecting to a stream, with the ID of the
+// last event they received.
+const lastEventIDHeader = "Last-Event-ID"
+
+// LastEventID is a strict middleware resuming the watches without resource_version after the
+// Last-Event-ID header, the strict handlers only receiving the parameters of the spec.
+func LastEventID(f server.StrictHandlerFunc, operationID string) server.StrictHandlerFunc {
+	if operationID != "WatchApplications" {
+		return f
+	}
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		watch := request.(server.WatchApplicationsRequestObject)
+		if header := r.Header.Get(lastEventIDHeader); header != "" && watch.Params.ResourceVersion == nil {
+			version, err := strconv.ParseInt(header, 10, 64)
+			if err != nil || version < 0 {
+				return server.WatchApplications400JSONResponse{Error: fmt.Sprintf("invalid %s header %q", lastEventIDHeader, header)}, nil
+			}
+			watch.Params.ResourceVersion = &version
+		}
+		return f(ctx, w, r, watch)
+	}
+}
+
+// (GET /applications:watch)
+func (s *ServiceHandler) WatchApplications(ctx context.Context, request server.WatchApplicationsRequestObject) (server.WatchApplicationsResponseObject, error) {
+	var since uint64
+	if request.Params.ResourceVersion != nil && *request.Params.ResourceVersion > 0 {
+		since = uint64(*request.Params.ResourceVersion)
+	}
+	events, version, err := s.ps.Watch(ctx, since)
+	if errors.Is(err, service.ErrResourceVersionExpired) {
+		return server.WatchApplications410JSONResponse{Error: err.Error()}, nil
+	}
+	if err != nil {
+		return server.WatchApplications500JSONResponse{Error: err.Error()}, nil
+	}
+	return watchStream{ctx: ctx, events: events, version: version}, nil
+}
+
+// watchStream writes the watch events as Server-Sent Events until the client goes away.
+type watchStream struct {
+	ctx     context.Context
+	events  <-chan service.WatchEvent
+	version uint64
+}
+
+func (ws watchStream) VisitWatchApplicationsResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming is not supported by the response writer")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	// Tell the client where the stream starts so it can resume even before the first event
+	if _, err := fmt.Fprintf(w, ": resource_version %d\n\n", ws.version); err != nil {
+		return err
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(watchKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case event, ok := <-ws.events:
+			if !ok {
+				// Dropped or cancelled, the client resumes from the last event ID
+				return nil
+			}
+			data, err := json.Marshal(server.WatchEvent{
+				ResourceVersion: int64(event.ResourceVersion),
+				Type:            event.Type,
+				Application:     event.Application,
+			})
+			if err != nil {
+				zap.S().Named("placement_service:watch").Warnw("Failed to encode watch event", "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ResourceVersion, event.Type, data); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+		case <-ws.ctx.Done():
+			return nil
+		}
+		flusher.Flush()
+	}
+}
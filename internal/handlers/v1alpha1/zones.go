@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// (GET /zones)
+func (s *ServiceHandler) ListZones(ctx context.Context, request server.ListZonesRequestObject) (server.ListZonesResponseObject, error) {
+	zones, err := s.store.Zone().List(ctx)
+	if err != nil {
+		return server.ListZones500JSONResponse{Error: err.Error()}, nil
+	}
+	response := make([]server.Zone, 0, len(zones))
+	for _, zone := range zones {
+		response = append(response, mappers.ZoneToAPI(zone))
+	}
+	return server.ListZones200JSONResponse{Zones: response}, nil
+}
+
+// (GET /zones/{id})
+func (s *ServiceHandler) GetZone(ctx context.Context, request server.GetZoneRequestObject) (server.GetZoneResponseObject, error) {
+	zone, err := s.store.Zone().Get(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetZone404JSONResponse{Error: fmt.Sprintf("zone %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetZone500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetZone200JSONResponse(mappers.ZoneToAPI(*zone)), nil
+}
+
+// (POST /zones/{id}:cordon)
+func (s *ServiceHandler) CordonZone(ctx context.Context, request server.CordonZoneRequestObject) (server.CordonZoneResponseObject, error) {
+	zone, err := s.ps.CordonZone(ctx, request.Id, true)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.CordonZone404JSONResponse{Error: fmt.Sprintf("zone %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.CordonZone500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.CordonZone200JSONResponse(mappers.ZoneToAPI(*zone)), nil
+}
+
+// (POST /zones/{id}:uncordon)
+func (s *ServiceHandler) UncordonZone(ctx context.Context, request server.UncordonZoneRequestObject) (server.UncordonZoneResponseObject, error) {
+	zone, err := s.ps.CordonZone(ctx, request.Id, false)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.UncordonZone404JSONResponse{Error: fmt.Sprintf("zone %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.UncordonZone500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.UncordonZone200JSONResponse(mappers.ZoneToAPI(*zone)), nil
+}
+
+// (POST /zones/{id}:drain)
+func (s *ServiceHandler) DrainZone(ctx context.Context, request server.DrainZoneRequestObject) (server.DrainZoneResponseObject, error) {
+	drain, err := s.ps.DrainZone(ctx, request.Id)
+	var inProgress *service.DrainInProgressError
+	if errors.As(err, &inProgress) {
+		return server.DrainZone409JSONResponse{Error: err.Error()}, nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.DrainZone404JSONResponse{Error: fmt.Sprintf("zone %s not found", request.Id)}, nil
+	}
+	if err != nil {
+		return server.DrainZone500JSONResponse{Error: err.Error()}, nil
+	}
+	zap.S().Named("placement_service").Info("Zone drain started. ", "Zone: ", request.Id, " Applications: ", len(drain.Applications))
+	return server.DrainZone202JSONResponse(mappers.ZoneDrainToAPI(*drain)), nil
+}
+
+// (GET /zones/{id}/drain)
+func (s *ServiceHandler) GetZoneDrain(ctx context.Context, request server.GetZoneDrainRequestObject) (server.GetZoneDrainResponseObject, error) {
+	drain, err := s.store.Drain().Latest(ctx, request.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return server.GetZoneDrain404JSONResponse{Error: fmt.Sprintf("zone %s was never drained", request.Id)}, nil
+	}
+	if err != nil {
+		return server.GetZoneDrain500JSONResponse{Error: err.Error()}, nil
+	}
+	return server.GetZoneDrain200JSONResponse(mappers.ZoneDrainToAPI(*drain)), nil
+}
@@ -0,0 +1,148 @@
+package httpclient
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the remote service while the breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// Breaker is a consecutive-failures circuit breaker. Once threshold calls failed in a row
+// it opens and rejects calls for openTimeout, then lets a single probe call through.
+type Breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = map[string]*Breaker{}
+)
+
+// NewBreaker creates a breaker and registers it under name for health reporting.
+// A threshold lower than 1 disables the breaker.
+func NewBreaker(name string, threshold int, openTimeout time.Duration) *Breaker {
+	b := &Breaker{
+		name:        name,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		state:       BreakerClosed,
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	breakers[name] = b
+	return b
+}
+
+// BreakerStatus is a snapshot of a breaker.
+type BreakerStatus struct {
+	Name     string
+	State    BreakerState
+	Failures int
+}
+
+// Breakers returns the status of every registered breaker, sorted by name.
+func Breakers() []BreakerStatus {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	statuses := make([]BreakerStatus, 0, len(breakers))
+	for _, b := range breakers {
+		statuses = append(statuses, b.Status())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// Status returns the current state of the breaker.
+func (b *Breaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return BreakerStatus{Name: b.name, State: b.currentState(), Failures: b.failures}
+}
+
+// Allow reports whether a call may proceed.
+func (b *Breaker) Allow() error {
+	if b.threshold < 1 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState() {
+	case BreakerOpen:
+		return ErrCircuitOpen
+	case BreakerHalfOpen:
+		// Only one probe at a time
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Success records a successful call and closes the breaker.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.probing = false
+	b.state = BreakerClosed
+}
+
+// Failure records a failed call, opening the breaker when the threshold is reached
+// or when the half-open probe failed.
+func (b *Breaker) Failure() {
+	if b.threshold < 1 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.probing || b.failures >= b.threshold {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+	}
+	b.probing = false
+}
+
+// Release ends a call that says nothing about the remote service, letting another probe
+// through when the call was the half-open probe.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// currentState must be called with mu held.
+func (b *Breaker) currentState() BreakerState {
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.openTimeout {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
@@ -0,0 +1,81 @@
+package httpclient
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	b := NewBreaker("test-threshold", 2, time.Hour)
+
+	b.Failure()
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected the breaker to stay closed after 1 failure, got %v", err)
+	}
+	b.Failure()
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the breaker to open after 2 failures, got %v", err)
+	}
+	if status := b.Status(); status.State != BreakerOpen || status.Failures != 2 {
+		t.Fatalf("expected open with 2 failures, got %+v", status)
+	}
+}
+
+func TestBreakerSuccessResetsFailures(t *testing.T) {
+	b := NewBreaker("test-reset", 2, time.Hour)
+
+	b.Failure()
+	b.Success()
+	b.Failure()
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected the failures to be reset by the success, got %v", err)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	b := NewBreaker("test-probe", 1, 10*time.Millisecond)
+
+	b.Failure()
+	time.Sleep(20 * time.Millisecond)
+	if state := b.Status().State; state != BreakerHalfOpen {
+		t.Fatalf("expected half-open after the timeout, got %s", state)
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected the probe to be let through, got %v", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected a single probe at a time, got %v", err)
+	}
+
+	// A released probe lets another one through
+	b.Release()
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected another probe after the release, got %v", err)
+	}
+
+	// A failed probe opens the breaker again
+	b.Failure()
+	if state := b.Status().State; state != BreakerOpen {
+		t.Fatalf("expected open after the failed probe, got %s", state)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected a probe after the timeout, got %v", err)
+	}
+	b.Success()
+	if status := b.Status(); status.State != BreakerClosed || status.Failures != 0 {
+		t.Fatalf("expected closed after the successful probe, got %+v", status)
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	b := NewBreaker("test-disabled", 0, time.Hour)
+
+	for range 5 {
+		b.Failure()
+	}
+	if err := b.Allow(); err != nil {
+		t.Fatalf("expected a disabled breaker to allow every call, got %v", err)
+	}
+}
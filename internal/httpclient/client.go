@@ -0,0 +1,171 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Config holds the resilience settings shared by the outbound HTTP clients.
+type Config struct {
+	Timeout          time.Duration
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	RetryMaxBackoff  time.Duration
+	BreakerThreshold int
+	BreakerTimeout   time.Duration
+}
+
+// Client is an HTTP doer applying per-call timeouts, jittered retries of idempotent
+// requests and a circuit breaker. It satisfies the generated clients' HttpRequestDoer.
+type Client struct {
+	http       *http.Client
+	cfg        Config
+	breaker    *Breaker
+	idempotent func(*http.Request) bool
+	logger     *zap.SugaredLogger
+}
+
+type Option func(*Client)
+
+// WithIdempotent overrides which requests are considered safe to retry.
+func WithIdempotent(fn func(*http.Request) bool) Option {
+	return func(c *Client) {
+		c.idempotent = fn
+	}
+}
+
+// AlwaysIdempotent marks every request as retryable, e.g. for side-effect free POST queries.
+func AlwaysIdempotent(*http.Request) bool {
+	return true
+}
+
+// New returns a client named after the remote service it talks to.
+func New(name string, cfg Config, opts ...Option) *Client {
+	c := &Client{
+		http:       &http.Client{Timeout: cfg.Timeout, Transport: sharedTransport},
+		cfg:        cfg,
+		breaker:    NewBreaker(name, cfg.BreakerThreshold, cfg.BreakerTimeout),
+		idempotent: isIdempotentMethod,
+		logger:     zap.S().Named("http_client:" + name),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// sharedTransport pools connections across all clients.
+var sharedTransport = http.DefaultTransport.(*http.Transport).Clone()
+
+func (c *Client) Breaker() *Breaker {
+	return c.breaker
+}
+
+// Do sends the request, retrying idempotent requests on connection errors and 5xx responses.
+// The breaker records a single outcome per request, whatever the number of attempts.
+func (c *Client) Do(req *http.Request) (resp *http.Response, err error) {
+	if err := c.breaker.Allow(); err != nil {
+		return nil, fmt.Errorf("%s: %w", c.breaker.Name(), err)
+	}
+	failed := false
+	defer func() {
+		switch {
+		case failed:
+			c.breaker.Failure()
+		case err == nil:
+			c.breaker.Success()
+		default:
+			// Cancelled by the caller, says nothing about the remote service
+			c.breaker.Release()
+		}
+	}()
+
+	retries := 0
+	if c.idempotent(req) {
+		retries = c.cfg.MaxRetries
+	}
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			if attemptReq, err = rewind(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = c.http.Do(attemptReq)
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if !isFailure(resp, err) {
+			return resp, nil
+		}
+
+		if attempt >= retries || req.Context().Err() != nil {
+			failed = true
+			return resp, err
+		}
+
+		delay := c.backoff(attempt)
+		c.logger.Debugw("Retrying request", "method", req.Method, "url", req.URL.String(), "attempt", attempt+1, "delay", delay, "error", err)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// backoff returns an exponential delay with full jitter.
+func (c *Client) backoff(attempt int) time.Duration {
+	if c.cfg.RetryBackoff <= 0 {
+		return 0
+	}
+	delay := c.cfg.RetryBackoff << attempt
+	if c.cfg.RetryMaxBackoff > 0 && delay > c.cfg.RetryMaxBackoff {
+		delay = c.cfg.RetryMaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+func isFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+func isIdempotentMethod(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rewind returns a copy of req with a fresh body for another attempt.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("cannot retry %s %s: request body is not replayable", req.Method, req.URL)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
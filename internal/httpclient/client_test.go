@@ -0,0 +1,157 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer answers with 500 to the first failures requests, then with 200.
+// It counts the requests it serves.
+func flakyServer(t *testing.T, failures int32, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testConfig() Config {
+	return Config{
+		Timeout:          time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+		RetryMaxBackoff:  5 * time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerTimeout:   time.Hour,
+	}
+}
+
+func get(t *testing.T, ctx context.Context, c *Client, url string) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := c.Do(req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestClientRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := flakyServer(t, 2, &requests)
+	c := New("test-retries", testConfig())
+
+	resp, err := get(t, context.Background(), c, server.URL)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the third attempt to succeed, got %v %v", resp, err)
+	}
+	if requests.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", requests.Load())
+	}
+	if status := c.Breaker().Status(); status.State != BreakerClosed || status.Failures != 0 {
+		t.Fatalf("expected the breaker closed without failures, got %+v", status)
+	}
+}
+
+func TestClientDoesNotRetryPost(t *testing.T) {
+	var requests atomic.Int32
+	server := flakyServer(t, 1, &requests)
+	c := New("test-post", testConfig())
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError || requests.Load() != 1 {
+		t.Fatalf("expected a single failed attempt, got %d after %d attempts", resp.StatusCode, requests.Load())
+	}
+}
+
+func TestClientCountsOneFailurePerRequest(t *testing.T) {
+	var requests atomic.Int32
+	server := flakyServer(t, 100, &requests)
+	c := New("test-failures", testConfig())
+
+	resp, err := get(t, context.Background(), c, server.URL)
+	if err != nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected the last 500 response, got %v %v", resp, err)
+	}
+	if requests.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", requests.Load())
+	}
+	if status := c.Breaker().Status(); status.State != BreakerClosed || status.Failures != 1 {
+		t.Fatalf("expected a single failure for the request, got %+v", status)
+	}
+
+	// The second failed request opens the breaker, the third is not sent
+	_, _ = get(t, context.Background(), c, server.URL)
+	if _, err := get(t, context.Background(), c, server.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the breaker to be open, got %v", err)
+	}
+	if requests.Load() != 6 {
+		t.Fatalf("expected 6 attempts, got %d", requests.Load())
+	}
+}
+
+func TestClientReleasesCancelledProbe(t *testing.T) {
+	var requests atomic.Int32
+	server := flakyServer(t, 0, &requests)
+	cfg := testConfig()
+	cfg.BreakerThreshold, cfg.BreakerTimeout = 1, 10*time.Millisecond
+	c := New("test-cancelled-probe", cfg)
+
+	c.Breaker().Failure()
+	time.Sleep(20 * time.Millisecond)
+
+	// The probe is cancelled by the caller, the breaker lets the next one through
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := get(t, ctx, c, server.URL); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+	if state := c.Breaker().Status().State; state != BreakerHalfOpen {
+		t.Fatalf("expected the breaker to stay half-open, got %s", state)
+	}
+	resp, err := get(t, context.Background(), c, server.URL)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the next probe to succeed, got %v %v", resp, err)
+	}
+	if state := c.Breaker().Status().State; state != BreakerClosed {
+		t.Fatalf("expected the breaker to close, got %s", state)
+	}
+}
+
+func TestClientBackoff(t *testing.T) {
+	c := New("test-backoff", Config{RetryBackoff: 10 * time.Millisecond, RetryMaxBackoff: 40 * time.Millisecond})
+
+	for attempt, limit := range []time.Duration{10, 20, 40, 40} {
+		limit *= time.Millisecond
+		for range 20 {
+			if delay := c.backoff(attempt); delay < 0 || delay > limit {
+				t.Fatalf("attempt %d: expected a delay up to %s, got %s", attempt, limit, delay)
+			}
+		}
+	}
+	if delay := New("test-no-backoff", Config{}).backoff(3); delay != 0 {
+		t.Fatalf("expected no delay without a backoff, got %s", delay)
+	}
+}
@@ -0,0 +1,464 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	APIVersion = "dcm.io/v1alpha1"
+	Kind       = "ApplicationList"
+)
+
+// Manifest is the declarative description of a set of applications.
+type Manifest struct {
+	APIVersion   string        `yaml:"apiVersion"`
+	Kind         string        `yaml:"kind"`
+	Applications []Application `yaml:"applications"`
+}
+
+// Application is an application of a manifest. Applications are matched with the stored
+// ones by ID when set, by name otherwise. Zones left empty are chosen by the policy, replicas
+// left empty keep the replicas the application is scaled to.
+type Application struct {
+	ID           string            `yaml:"id,omitempty"`
+	Name         string            `yaml:"name"`
+	Service      string            `yaml:"service"`
+	Tier         int               `yaml:"tier,omitempty"`
+	Tenant       string            `yaml:"tenant,omitempty"`
+	Zones        []string          `yaml:"zones,omitempty"`
+	Labels       map[string]string `yaml:"labels,omitempty"`
+	Annotations  map[string]string `yaml:"annotations,omitempty"`
+	Affinity     *Selector         `yaml:"affinity,omitempty"`
+	AntiAffinity *Selector         `yaml:"antiAffinity,omitempty"`
+	Replicas     int               `yaml:"replicas,omitempty"`
+	ZoneReplicas map[string]int    `yaml:"zoneReplicas,omitempty"`
+}
+
+// Selector references applications by ID or by labels in the affinity rules.
+type Selector struct {
+	ApplicationIDs []string          `yaml:"applicationIds,omitempty"`
+	MatchLabels    map[string]string `yaml:"matchLabels,omitempty"`
+}
+
+// Load reads a manifest from path, "-" reads the standard input.
+func Load(path string) (*Manifest, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a manifest.
+func Parse(data []byte) (*Manifest, error) {
+	var m Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&m); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks the header and the uniqueness of the application IDs and names.
+func (m *Manifest) Validate() error {
+	if m.APIVersion != "" && m.APIVersion != APIVersion {
+		return fmt.Errorf("unsupported apiVersion %q, expected %q", m.APIVersion, APIVersion)
+	}
+	if m.Kind != "" && m.Kind != Kind {
+		return fmt.Errorf("unsupported kind %q, expected %q", m.Kind, Kind)
+	}
+	ids := map[string]bool{}
+	names := map[string]bool{}
+	for i, app := range m.Applications {
+		if app.Name == "" {
+			return fmt.Errorf("application %d: name is required", i)
+		}
+		switch server.ApplicationService(app.Service) {
+		case server.Webserver, server.Container:
+		default:
+			return fmt.Errorf("application %q: unknown service %q", app.Name, app.Service)
+		}
+		if err := app.validateSelectors(); err != nil {
+			return fmt.Errorf("application %q: %w", app.Name, err)
+		}
+		if app.Replicas < 0 {
+			return fmt.Errorf("application %q: replicas must be positive, got %d", app.Name, app.Replicas)
+		}
+		for zone, replicas := range app.ZoneReplicas {
+			if replicas < 1 {
+				return fmt.Errorf("application %q: replicas must be positive, got %d in zone %s", app.Name, replicas, zone)
+			}
+		}
+		if app.ID != "" {
+			if _, err := uuid.Parse(app.ID); err != nil {
+				return fmt.Errorf("application %q: invalid id %q", app.Name, app.ID)
+			}
+			if ids[app.ID] {
+				return fmt.Errorf("application id %s appears more than once", app.ID)
+			}
+			ids[app.ID] = true
+		} else {
+			if names[app.Name] {
+				return fmt.Errorf("application %q appears more than once without an id", app.Name)
+			}
+			names[app.Name] = true
+		}
+	}
+	return nil
+}
+
+func (a Application) validateSelectors() error {
+	for _, selector := range []*Selector{a.Affinity, a.AntiAffinity} {
+		if selector == nil {
+			continue
+		}
+		for _, id := range selector.ApplicationIDs {
+			if _, err := uuid.Parse(id); err != nil {
+				return fmt.Errorf("invalid application id %q in affinity", id)
+			}
+		}
+	}
+	return nil
+}
+
+// Export describes the stored applications as a manifest.
+func Export(apps model.ApplicationList) *Manifest {
+	m := &Manifest{APIVersion: APIVersion, Kind: Kind, Applications: []Application{}}
+	for _, app := range apps {
+		m.Applications = append(m.Applications, fromModel(app))
+	}
+	sort.Slice(m.Applications, func(i, j int) bool {
+		if m.Applications[i].Name != m.Applications[j].Name {
+			return m.Applications[i].Name < m.Applications[j].Name
+		}
+		return m.Applications[i].ID < m.Applications[j].ID
+	})
+	return m
+}
+
+func fromModel(app model.Application) Application {
+	return Application{
+		ID:           app.ID.String(),
+		Name:         app.Name,
+		Service:      app.Service,
+		Tier:         app.Tier,
+		Tenant:       app.Tenant,
+		Zones:        append([]string{}, app.Zones...),
+		Labels:       app.Labels,
+		Annotations:  app.Annotations,
+		Affinity:     selectorFromModel(app.Affinity),
+		AntiAffinity: selectorFromModel(app.AntiAffinity),
+		Replicas:     app.Replicas,
+		ZoneReplicas: app.ZoneReplicas,
+	}
+}
+
+func selectorFromModel(selector *model.ApplicationSelector) *Selector {
+	if selector == nil {
+		return nil
+	}
+	result := &Selector{MatchLabels: selector.MatchLabels}
+	for _, id := range selector.ApplicationIDs {
+		result.ApplicationIDs = append(result.ApplicationIDs, id.String())
+	}
+	return result
+}
+
+// Action is the change applied to converge an application.
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionDelete    Action = "delete"
+	ActionUnchanged Action = "unchanged"
+)
+
+// Change is a step of a plan. Desired is unset for deletions, Current for creations.
+type Change struct {
+	Action  Action
+	Desired *Application
+	Current *Application
+	// Diff lists the fields that differ for updates
+	Diff []string
+}
+
+// Name returns the name of the application affected by the change.
+func (c Change) Name() string {
+	if c.Desired != nil {
+		return c.Desired.Name
+	}
+	return c.Current.Name
+}
+
+// Plan computes the changes converging the stored applications to the manifest.
+// Stored applications missing from the manifest are only deleted when prune is set.
+func Plan(m *Manifest, apps model.ApplicationList, prune bool) ([]Change, error) {
+	byID := map[string]model.Application{}
+	byName := map[string][]model.Application{}
+	for _, app := range apps {
+		byID[app.ID.String()] = app
+		byName[app.Name] = append(byName[app.Name], app)
+	}
+
+	matched := map[string]bool{}
+	var changes []Change
+	for i := range m.Applications {
+		desired := m.Applications[i]
+
+		var current *model.Application
+		if desired.ID != "" {
+			if app, ok := byID[desired.ID]; ok {
+				current = &app
+			}
+		} else if candidates := byName[desired.Name]; len(candidates) == 1 {
+			current = &candidates[0]
+		} else if len(candidates) > 1 {
+			return nil, fmt.Errorf("application %q matches %d stored applications, set its id", desired.Name, len(candidates))
+		}
+
+		if current == nil {
+			changes = append(changes, Change{Action: ActionCreate, Desired: &desired})
+			continue
+		}
+		matched[current.ID.String()] = true
+		existing := fromModel(*current)
+		if desired.ID == "" {
+			desired.ID = existing.ID
+		}
+		change := Change{Action: ActionUnchanged, Desired: &desired, Current: &existing}
+		if diff := diffApplication(desired, existing); len(diff) > 0 {
+			change.Action = ActionUpdate
+			change.Diff = diff
+		}
+		changes = append(changes, change)
+	}
+
+	if prune {
+		for _, app := range apps {
+			if matched[app.ID.String()] {
+				continue
+			}
+			existing := fromModel(app)
+			changes = append(changes, Change{Action: ActionDelete, Current: &existing})
+		}
+	}
+	return changes, nil
+}
+
+// diffApplication describes the fields of desired differing from current.
+func diffApplication(desired, current Application) []string {
+	return append(diffSpec(desired, current), diffReplicas(desired, current)...)
+}
+
+// diffSpec describes the fields of desired differing from current that need the application
+// to be redeployed.
+func diffSpec(desired, current Application) []string {
+	var diff []string
+	if desired.Name != current.Name {
+		diff = append(diff, fmt.Sprintf("name: %q -> %q", current.Name, desired.Name))
+	}
+	if desired.Service != current.Service {
+		diff = append(diff, fmt.Sprintf("service: %s -> %s", current.Service, desired.Service))
+	}
+	if tier := desired.EffectiveTier(); tier != current.Tier {
+		diff = append(diff, fmt.Sprintf("tier: %d -> %d", current.Tier, tier))
+	}
+	if tenant := desired.EffectiveTenant(); tenant != current.EffectiveTenant() {
+		diff = append(diff, fmt.Sprintf("tenant: %q -> %q", current.EffectiveTenant(), tenant))
+	}
+	// Zones chosen by the policy are not drift
+	if len(desired.Zones) > 0 && !slices.Equal(sorted(desired.Zones), sorted(current.Zones)) {
+		diff = append(diff, fmt.Sprintf("zones: %v -> %v", current.Zones, desired.Zones))
+	}
+	if !maps.Equal(desired.Labels, current.Labels) {
+		diff = append(diff, fmt.Sprintf("labels: %v -> %v", current.Labels, desired.Labels))
+	}
+	if !maps.Equal(desired.Annotations, current.Annotations) {
+		diff = append(diff, fmt.Sprintf("annotations: %v -> %v", current.Annotations, desired.Annotations))
+	}
+	if !desired.Affinity.equal(current.Affinity) {
+		diff = append(diff, fmt.Sprintf("affinity: %v -> %v", current.Affinity, desired.Affinity))
+	}
+	if !desired.AntiAffinity.equal(current.AntiAffinity) {
+		diff = append(diff, fmt.Sprintf("antiAffinity: %v -> %v", current.AntiAffinity, desired.AntiAffinity))
+	}
+	return diff
+}
+
+// diffReplicas describes the replicas of desired differing from current. Replicas left
+// empty are not drift.
+func diffReplicas(desired, current Application) []string {
+	var diff []string
+	if desired.Replicas > 0 && desired.Replicas != current.Replicas {
+		diff = append(diff, fmt.Sprintf("replicas: %d -> %d", current.Replicas, desired.Replicas))
+	}
+	if len(desired.ZoneReplicas) > 0 && !maps.Equal(desired.ZoneReplicas, current.ZoneReplicas) {
+		diff = append(diff, fmt.Sprintf("zoneReplicas: %v -> %v", current.ZoneReplicas, desired.ZoneReplicas))
+	}
+	return diff
+}
+
+// equal reports whether s and other reference the same applications.
+func (s *Selector) equal(other *Selector) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return slices.Equal(sorted(s.ApplicationIDs), sorted(other.ApplicationIDs)) && maps.Equal(s.MatchLabels, other.MatchLabels)
+}
+
+func (s *Selector) String() string {
+	return fmt.Sprintf("{applicationIds: %v, matchLabels: %v}", s.ApplicationIDs, s.MatchLabels)
+}
+
+// EffectiveTier returns the tier of the application, applying the API default.
+func (a Application) EffectiveTier() int {
+	if a.Tier == 0 {
+		return 2
+	}
+	return a.Tier
+}
+
+// EffectiveTenant returns the tenant of the application, applying the API default.
+func (a Application) EffectiveTenant() string {
+	if a.Tenant == "" {
+		return service.DefaultTenant
+	}
+	return a.Tenant
+}
+
+func sorted(items []string) []string {
+	out := slices.Clone(items)
+	slices.Sort(out)
+	return out
+}
+
+// Placer creates, replaces and deletes applications, as PlacementService does.
+type Placer interface {
+	CreateApplication(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string, opts service.CreateOptions) (*server.ApplicationResponse, error)
+	ReplaceApplication(ctx context.Context, id uuid.UUID, request *server.CreateApplicationJSONRequestBody) (*server.ApplicationResponse, error)
+	ScaleApplication(ctx context.Context, id uuid.UUID, opts service.ScaleOptions) (*server.ApplicationResponse, error)
+	DeleteApplication(ctx context.Context, id uuid.UUID) (*server.ApplicationResponse, error)
+}
+
+// Apply executes the changes in order. Updates redeploy the application under the same ID
+// since deployments cannot be changed in place, the former deployments being deleted once
+// the new ones are created. Applications whose replicas alone changed are scaled. It stops
+// at the first failure.
+func Apply(ctx context.Context, placer Placer, changes []Change, report func(Change, error)) error {
+	for _, change := range changes {
+		err := applyChange(ctx, placer, change)
+		report(change, err)
+		if err != nil {
+			return fmt.Errorf("failed to %s application %q: %w", change.Action, change.Name(), err)
+		}
+	}
+	return nil
+}
+
+func applyChange(ctx context.Context, placer Placer, change Change) error {
+	switch change.Action {
+	case ActionCreate:
+		return create(ctx, placer, change.Desired)
+	case ActionUpdate:
+		id, _ := uuid.Parse(change.Current.ID)
+		if len(diffSpec(*change.Desired, *change.Current)) > 0 {
+			if _, err := placer.ReplaceApplication(ctx, id, toRequest(change.Desired)); err != nil {
+				return err
+			}
+		}
+		if len(diffReplicas(*change.Desired, *change.Current)) > 0 {
+			return scale(ctx, placer, id, change.Desired)
+		}
+		return nil
+	case ActionDelete:
+		id, _ := uuid.Parse(change.Current.ID)
+		_, err := placer.DeleteApplication(ctx, id)
+		return err
+	}
+	return nil
+}
+
+func create(ctx context.Context, placer Placer, app *Application) error {
+	created, err := placer.CreateApplication(ctx, toRequest(app), app.ID, service.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if app.Replicas > 0 || len(app.ZoneReplicas) > 0 {
+		return scale(ctx, placer, *created.Id, app)
+	}
+	return nil
+}
+
+func scale(ctx context.Context, placer Placer, id uuid.UUID, app *Application) error {
+	_, err := placer.ScaleApplication(ctx, id, service.ScaleOptions{Replicas: app.Replicas, ZoneReplicas: app.ZoneReplicas})
+	return err
+}
+
+// toRequest returns the API request describing app.
+func toRequest(app *Application) *server.CreateApplicationJSONRequestBody {
+	tier := app.EffectiveTier()
+	request := &server.Application{
+		Name:    app.Name,
+		Service: server.ApplicationService(app.Service),
+		Tier:    &tier,
+	}
+	if app.Tenant != "" {
+		request.Tenant = &app.Tenant
+	}
+	if len(app.Zones) > 0 {
+		zones := app.Zones
+		request.Zones = &zones
+	}
+	if len(app.Labels) > 0 {
+		request.Labels = &app.Labels
+	}
+	if len(app.Annotations) > 0 {
+		request.Annotations = &app.Annotations
+	}
+	request.Affinity = selectorToAPI(app.Affinity)
+	request.AntiAffinity = selectorToAPI(app.AntiAffinity)
+	return request
+}
+
+// selectorToAPI converts a selector checked by Validate.
+func selectorToAPI(selector *Selector) *server.ApplicationSelector {
+	if selector == nil {
+		return nil
+	}
+	result := &server.ApplicationSelector{}
+	if len(selector.ApplicationIDs) > 0 {
+		ids := make([]uuid.UUID, 0, len(selector.ApplicationIDs))
+		for _, id := range selector.ApplicationIDs {
+			ids = append(ids, uuid.MustParse(id))
+		}
+		result.ApplicationIds = &ids
+	}
+	if len(selector.MatchLabels) > 0 {
+		result.MatchLabels = &selector.MatchLabels
+	}
+	return result
+}
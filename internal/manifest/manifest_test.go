@@ -0,0 +1,180 @@
+package manifest
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/service"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func TestPlan(t *testing.T) {
+	web := model.Application{ID: uuid.New(), Name: "web", Service: "webserver", Tier: 1, Zones: []string{"us-east-1", "us-east-2"}}
+	api := model.Application{ID: uuid.New(), Name: "api", Service: "container", Tier: 2, Zones: []string{"us-west-1"}}
+	old := model.Application{ID: uuid.New(), Name: "old", Service: "container", Tier: 2}
+
+	m, err := Parse([]byte(`
+apiVersion: dcm.io/v1alpha1
+kind: ApplicationList
+applications:
+  - name: web
+    service: webserver
+    tier: 1
+    zones: [us-east-2, us-east-1]
+  - id: ` + api.ID.String() + `
+    name: api
+    service: container
+    tier: 1
+  - name: db
+    service: container
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	for _, prune := range []bool{false, true} {
+		changes, err := Plan(m, model.ApplicationList{web, api, old}, prune)
+		if err != nil {
+			t.Fatalf("Plan: %v", err)
+		}
+		expected := []Action{ActionUnchanged, ActionUpdate, ActionCreate}
+		if prune {
+			expected = append(expected, ActionDelete)
+		}
+		if len(changes) != len(expected) {
+			t.Fatalf("prune=%v: expected %d changes, got %+v", prune, len(expected), changes)
+		}
+		for i, change := range changes {
+			if change.Action != expected[i] {
+				t.Fatalf("prune=%v: change %d: expected %s, got %s", prune, i, expected[i], change.Action)
+			}
+		}
+		if changes[0].Desired.ID != web.ID.String() {
+			t.Fatalf("expected web to be matched by name, got id %q", changes[0].Desired.ID)
+		}
+		if len(changes[1].Diff) != 1 {
+			t.Fatalf("expected only the tier of api to differ, got %v", changes[1].Diff)
+		}
+		if prune && changes[3].Current.Name != "old" {
+			t.Fatalf("expected old to be pruned, got %s", changes[3].Current.Name)
+		}
+	}
+}
+
+func TestParseRejectsInvalidManifests(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown field":     "applications:\n  - name: web\n    service: webserver\n    size: 2\n",
+		"negative replicas": "applications:\n  - name: web\n    service: container\n    replicas: -1\n",
+		"invalid affinity":  "applications:\n  - name: web\n    service: container\n    affinity:\n      applicationIds: [db]\n",
+		"unknown service":   "applications:\n  - name: web\n    service: database\n",
+		"duplicate name":    "applications:\n  - name: web\n    service: webserver\n  - name: web\n    service: container\n",
+		"wrong kind":        "kind: Application\napplications: []\n",
+	} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestPlanDetectsMetadataAndReplicas(t *testing.T) {
+	db := uuid.New()
+	api := model.Application{
+		ID: uuid.New(), Name: "api", Service: "container", Tier: 2, Tenant: "default", Zones: []string{"us-west-1"},
+		Labels:       map[string]string{"team": "payments"},
+		AntiAffinity: &model.ApplicationSelector{ApplicationIDs: []uuid.UUID{db}},
+		Replicas:     3,
+	}
+
+	for name, tt := range map[string]struct {
+		manifest string
+		diff     []string
+	}{
+		"unchanged": {
+			manifest: "name: api\n    service: container\n    tenant: default\n    labels: {team: payments}\n    antiAffinity:\n      applicationIds: [" + db.String() + "]\n",
+		},
+		"labels and tenant": {
+			manifest: "name: api\n    service: container\n    tenant: team-a\n    labels: {team: billing}\n    antiAffinity:\n      applicationIds: [" + db.String() + "]\n",
+			diff:     []string{`tenant: "default" -> "team-a"`, "labels: map[team:payments] -> map[team:billing]"},
+		},
+		"affinity and replicas": {
+			manifest: "name: api\n    service: container\n    labels: {team: payments}\n    replicas: 2\n",
+			diff:     []string{"antiAffinity: {applicationIds: [" + db.String() + "], matchLabels: map[]} -> <nil>", "replicas: 3 -> 2"},
+		},
+	} {
+		m, err := Parse([]byte("applications:\n  - " + tt.manifest))
+		if err != nil {
+			t.Fatalf("%s: Parse: %v", name, err)
+		}
+		changes, err := Plan(m, model.ApplicationList{api}, false)
+		if err != nil {
+			t.Fatalf("%s: Plan: %v", name, err)
+		}
+		if !slices.Equal(changes[0].Diff, tt.diff) {
+			t.Fatalf("%s: expected diff %q, got %q", name, tt.diff, changes[0].Diff)
+		}
+	}
+}
+
+// recordingPlacer records the calls of Apply.
+type recordingPlacer struct {
+	calls []string
+}
+
+func (p *recordingPlacer) CreateApplication(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string, opts service.CreateOptions) (*server.ApplicationResponse, error) {
+	p.calls = append(p.calls, "create "+request.Name)
+	id := uuid.New()
+	return &server.ApplicationResponse{Id: &id}, nil
+}
+
+func (p *recordingPlacer) ReplaceApplication(ctx context.Context, id uuid.UUID, request *server.CreateApplicationJSONRequestBody) (*server.ApplicationResponse, error) {
+	p.calls = append(p.calls, "replace "+request.Name)
+	return &server.ApplicationResponse{Id: &id}, nil
+}
+
+func (p *recordingPlacer) ScaleApplication(ctx context.Context, id uuid.UUID, opts service.ScaleOptions) (*server.ApplicationResponse, error) {
+	p.calls = append(p.calls, "scale")
+	return &server.ApplicationResponse{Id: &id}, nil
+}
+
+func (p *recordingPlacer) DeleteApplication(ctx context.Context, id uuid.UUID) (*server.ApplicationResponse, error) {
+	p.calls = append(p.calls, "delete")
+	return &server.ApplicationResponse{Id: &id}, nil
+}
+
+func TestApply(t *testing.T) {
+	web := model.Application{ID: uuid.New(), Name: "web", Service: "container", Tier: 2, Replicas: 2}
+	api := model.Application{ID: uuid.New(), Name: "api", Service: "container", Tier: 2}
+	m, err := Parse([]byte(`
+applications:
+  - name: web
+    service: container
+    replicas: 3
+  - name: api
+    service: container
+    labels: {team: payments}
+  - name: db
+    service: container
+    replicas: 2
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	changes, err := Plan(m, model.ApplicationList{web, api}, false)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	placer := &recordingPlacer{}
+	if err := Apply(context.Background(), placer, changes, func(Change, error) {}); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	// web is only scaled, api redeployed with its labels, db scaled once created
+	expected := []string{"scale", "replace api", "create db", "scale"}
+	if !slices.Equal(placer.calls, expected) {
+		t.Fatalf("expected %v, got %v", expected, placer.calls)
+	}
+}
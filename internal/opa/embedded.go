@@ -0,0 +1,214 @@
+package opa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+)
+
+// Bundle is a set of policies and their data, as served by OPA.
+type Bundle struct {
+	// Modules are the sources of the rego files by file name
+	Modules map[string]string
+	Data    map[string]interface{}
+}
+
+// LoadBundle reads the rego files and data.json files of dir. The data of a data.json file
+// is placed under the path of its directory, as in OPA bundles.
+func LoadBundle(dir string) (*Bundle, error) {
+	bundle := &Bundle{Modules: map[string]string{}, Data: map[string]interface{}{}}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.HasSuffix(path, ".rego") && !strings.HasSuffix(path, "_test.rego"):
+			source, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			bundle.Modules[filepath.ToSlash(rel)] = string(source)
+		case d.Name() == "data.json":
+			raw, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			var data map[string]interface{}
+			if err := json.Unmarshal(raw, &data); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", rel, err)
+			}
+			mergeData(bundle.Data, filepath.ToSlash(filepath.Dir(rel)), data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bundle %s: %w", dir, err)
+	}
+	if len(bundle.Modules) == 0 {
+		return nil, fmt.Errorf("no policy found in %s", dir)
+	}
+	return bundle, nil
+}
+
+// mergeData places data under the slash separated dir of root, "." being root itself.
+func mergeData(root map[string]interface{}, dir string, data map[string]interface{}) {
+	node := root
+	if dir != "." {
+		for _, key := range strings.Split(dir, "/") {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[key] = child
+			}
+			node = child
+		}
+	}
+	for key, value := range data {
+		node[key] = value
+	}
+}
+
+// embeddedEvalTimeout bounds the evaluation of a policy of an embedded engine
+const embeddedEvalTimeout = 5 * time.Second
+
+// embeddedCapabilities are the builtins available to the policies of embedded engines. The
+// policies may be sent by API callers, so builtins reaching the network are left out.
+func embeddedCapabilities() *ast.Capabilities {
+	capabilities := ast.CapabilitiesForThisVersion()
+	builtins := capabilities.Builtins[:0]
+	for _, builtin := range capabilities.Builtins {
+		if builtin.Name == "http.send" || strings.HasPrefix(builtin.Name, "net.") {
+			continue
+		}
+		builtins = append(builtins, builtin)
+	}
+	capabilities.Builtins = builtins
+	return capabilities
+}
+
+// EmbeddedEngine evaluates the policies of a bundle in process, without an OPA server.
+type EmbeddedEngine struct {
+	compiler     *ast.Compiler
+	capabilities *ast.Capabilities
+	store        storage.Store
+	packages     map[string]bool
+
+	mu       sync.Mutex
+	prepared map[string]rego.PreparedEvalQuery
+}
+
+var _ Engine = (*EmbeddedEngine)(nil)
+
+// NewEmbeddedEngine compiles the policies of bundle. Policies calling http.send or the net
+// builtins are rejected, and every evaluation is bounded by embeddedEvalTimeout.
+func NewEmbeddedEngine(bundle *Bundle) (*EmbeddedEngine, error) {
+	capabilities := embeddedCapabilities()
+	modules := make(map[string]*ast.Module, len(bundle.Modules))
+	for name, source := range bundle.Modules {
+		module, err := ast.ParseModuleWithOpts(name, source, ast.ParserOptions{Capabilities: capabilities})
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		modules[name] = module
+	}
+	compiler := ast.NewCompiler().WithCapabilities(capabilities)
+	if compiler.Compile(modules); compiler.Failed() {
+		return nil, fmt.Errorf("failed to compile policies: %w", compiler.Errors)
+	}
+	packages := map[string]bool{}
+	for _, module := range compiler.Modules {
+		packages[strings.TrimPrefix(module.Package.Path.String(), "data.")] = true
+	}
+	data := bundle.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	return &EmbeddedEngine{
+		compiler:     compiler,
+		capabilities: capabilities,
+		store:        inmem.NewFromObject(data),
+		packages:     packages,
+		prepared:     map[string]rego.PreparedEvalQuery{},
+	}, nil
+}
+
+func (e *EmbeddedEngine) PolicyExists(ctx context.Context, policy string) (bool, error) {
+	if err := ValidatePolicyPath(policy); err != nil {
+		return false, err
+	}
+	return e.packages[strings.ReplaceAll(policy, "/", ".")], nil
+}
+
+func (e *EmbeddedEngine) EvalTierPolicy(ctx context.Context, policy string, input TierInput) (*Decision, error) {
+	exists, err := e.PolicyExists(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("policy %q is not in the bundle", policy)
+	}
+	query, err := e.query(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// The policies receive the input as OPA would decode it from JSON
+	var generic interface{}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, embeddedEvalTimeout)
+	defer cancel()
+	results, err := query.Eval(ctx, rego.EvalInput(generic))
+	if err != nil {
+		return nil, fmt.Errorf("policy evaluation failed: %w", err)
+	}
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return nil, fmt.Errorf("policy %q is undefined", policy)
+	}
+	result, ok := results[0].Expressions[0].Value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("policy %q did not return an object", policy)
+	}
+	return &Decision{Result: result}, nil
+}
+
+// query returns the prepared query of policy, prepared on first use.
+func (e *EmbeddedEngine) query(ctx context.Context, policy string) (rego.PreparedEvalQuery, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if query, ok := e.prepared[policy]; ok {
+		return query, nil
+	}
+	query, err := rego.New(
+		rego.Query("data."+strings.ReplaceAll(policy, "/", ".")),
+		rego.Compiler(e.compiler),
+		rego.Store(e.store),
+		rego.Capabilities(e.capabilities),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return rego.PreparedEvalQuery{}, fmt.Errorf("failed to prepare policy %q: %w", policy, err)
+	}
+	e.prepared[policy] = query
+	return query, nil
+}
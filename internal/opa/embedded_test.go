@@ -0,0 +1,30 @@
+package opa
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedEngineCapabilities(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "http.send", body: `http.send({"method": "GET", "url": "http://169.254.169.254/"})`},
+		{name: "net.lookup_ip_addr", body: `net.lookup_ip_addr("internal.example")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle := &Bundle{Modules: map[string]string{
+				"tier.rego": "package tier1\n\nimport rego.v1\n\nvalid if " + tt.body + "\n",
+			}}
+			_, err := NewEmbeddedEngine(bundle)
+			if err == nil || !strings.Contains(err.Error(), tt.name) {
+				t.Fatalf("expected %s to be rejected, got %v", tt.name, err)
+			}
+		})
+	}
+
+	// The sample policies only use the builtins left available
+	policyEngine(t)
+}
@@ -0,0 +1,223 @@
+package opa
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/google/uuid"
+)
+
+// FakeEngine is an in-process policy engine mirroring the tier policies:
+// every tier requires a fixed set of zones and user supplied zones must match it exactly.
+// Applications failed over to the backup environment require the backup zones of the tier.
+// Zones lacking the capacity required by the application or breaking its affinity rules
+// are rejected.
+type FakeEngine struct {
+	mu         sync.RWMutex
+	zones      map[string][]string
+	backup     map[string][]string
+	candidates map[string]fakeCandidates
+	revision   string
+	err        error
+}
+
+type fakeCandidates struct {
+	zones        []ZoneCandidate
+	replicaZones int
+}
+
+var _ Engine = (*FakeEngine)(nil)
+
+// NewFakeEngine returns a fake engine requiring the given zones for each tier, serving
+// the policies at the paths of TierPolicyPath.
+func NewFakeEngine(zones map[int][]string) *FakeEngine {
+	f := &FakeEngine{zones: map[string][]string{}, backup: map[string][]string{}, candidates: map[string]fakeCandidates{}}
+	for tier, z := range zones {
+		f.zones[TierPolicyPath(tier)] = z
+	}
+	return f
+}
+
+// NewDevFakeEngine returns a fake engine with the zones used by the sample tier policies.
+func NewDevFakeEngine() *FakeEngine {
+	f := NewFakeEngine(map[int][]string{
+		1: {"us-east-1", "us-east-2"},
+		2: {"us-west-1"},
+	})
+	f.SetBackupZones(1, []string{"us-central-1"})
+	f.SetBackupZones(2, []string{"us-central-2"})
+	return f
+}
+
+// SetZones replaces the zones required for tier.
+func (f *FakeEngine) SetZones(tier int, zones []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.zones[TierPolicyPath(tier)] = zones
+}
+
+// SetBackupZones replaces the zones required for tier once applications failed over.
+func (f *FakeEngine) SetBackupZones(tier int, zones []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.backup[TierPolicyPath(tier)] = zones
+}
+
+// SetCandidates makes tier return scored candidate zones, of which replicaZones are wanted,
+// instead of required zones. User supplied zones must then be candidates.
+func (f *FakeEngine) SetCandidates(tier int, candidates []ZoneCandidate, replicaZones int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.candidates[TierPolicyPath(tier)] = fakeCandidates{zones: candidates, replicaZones: replicaZones}
+}
+
+// SetRevision sets the bundle revision reported by the engine.
+func (f *FakeEngine) SetRevision(revision string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.revision = revision
+}
+
+// SetError makes every evaluation fail with err until reset with nil.
+func (f *FakeEngine) SetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// PolicyExists reports whether zones or candidates are set for the policy.
+func (f *FakeEngine) PolicyExists(ctx context.Context, policy string) (bool, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.err != nil {
+		return false, f.err
+	}
+	_, hasZones := f.zones[policy]
+	_, hasCandidates := f.candidates[policy]
+	return hasZones || hasCandidates, nil
+}
+
+// EvalTierPolicy returns a decision with a random ID made at the current revision.
+func (f *FakeEngine) EvalTierPolicy(ctx context.Context, policy string, input TierInput) (*Decision, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	var result map[string]interface{}
+	if candidates, ok := f.candidates[policy]; ok {
+		result = f.evalCandidates(candidates, input)
+	} else if required, ok := f.zones[policy]; ok {
+		if input.Environment == "backup" {
+			required = f.backup[policy]
+		}
+		result = f.evalZones(required, input)
+	} else {
+		return nil, fmt.Errorf("policy %q is not served by OPA", policy)
+	}
+	return &Decision{ID: uuid.NewString(), Revision: f.revision, Result: result}, nil
+}
+
+func (f *FakeEngine) evalZones(required []string, input TierInput) map[string]interface{} {
+
+	requiredZones := make([]interface{}, 0, len(required))
+	for _, zone := range required {
+		requiredZones = append(requiredZones, zone)
+	}
+
+	failures := []interface{}{}
+	if zones := input.Zones; zones != nil {
+		for _, zone := range required {
+			if !slices.Contains(*zones, zone) {
+				failures = append(failures, fmt.Sprintf("Missing required zone '%s' in input specification", zone))
+			}
+		}
+		for _, zone := range *zones {
+			if !slices.Contains(required, zone) {
+				failures = append(failures, fmt.Sprintf("Unexpected zone '%s' in input specification", zone))
+			}
+		}
+	}
+
+	for _, zone := range required {
+		usage, ok := input.Capacity[zone]
+		if ok && usage.Available != nil && !usage.Available.Fits(input.Requirements) {
+			failures = append(failures, capacityFailure(zone, *usage.Available, input.Requirements))
+		}
+	}
+	placed := required
+	if input.Zones != nil {
+		placed = *input.Zones
+	}
+	failures = append(failures, affinityFailures(placed, input)...)
+
+	return map[string]interface{}{
+		"valid":          len(failures) == 0,
+		"required_zones": requiredZones,
+		"failures":       failures,
+	}
+}
+
+func (f *FakeEngine) evalCandidates(candidates fakeCandidates, input TierInput) map[string]interface{} {
+	names := []string{}
+	candidateZones := []interface{}{}
+	failures := []interface{}{}
+	for _, c := range candidates.zones {
+		names = append(names, c.Zone)
+		// Saturated zones and zones breaking the affinity rules are not candidates
+		if usage, ok := input.Capacity[c.Zone]; ok && usage.Available != nil && !usage.Available.Fits(input.Requirements) {
+			continue
+		}
+		if len(affinityFailures([]string{c.Zone}, input)) > 0 {
+			continue
+		}
+		candidateZones = append(candidateZones, map[string]interface{}{
+			"zone":   c.Zone,
+			"region": c.Region,
+			"score":  c.Score,
+			"reason": c.Reason,
+		})
+	}
+	if input.Zones != nil {
+		for _, zone := range *input.Zones {
+			if !slices.Contains(names, zone) {
+				failures = append(failures, fmt.Sprintf("Unexpected zone '%s' in input specification", zone))
+			}
+		}
+		failures = append(failures, affinityFailures(*input.Zones, input)...)
+	} else if len(candidateZones) == 0 {
+		failures = append(failures, affinityFailures(names, input)...)
+	}
+	return map[string]interface{}{
+		"valid":           len(failures) == 0,
+		"candidate_zones": candidateZones,
+		"replica_zones":   float64(candidates.replicaZones),
+		"failures":        failures,
+	}
+}
+
+func affinityFailures(zones []string, input TierInput) []interface{} {
+	failures := []interface{}{}
+	if input.Affinity != nil && len(input.Affinity.Applications) == 0 {
+		failures = append(failures, "Affinity matches no application")
+	}
+	for _, zone := range zones {
+		if input.Affinity != nil && len(input.Affinity.Applications) > 0 && !slices.Contains(input.Affinity.Zones, zone) {
+			failures = append(failures, fmt.Sprintf("Zone '%s' hosts none of the applications required by affinity", zone))
+		}
+		if input.AntiAffinity != nil && slices.Contains(input.AntiAffinity.Zones, zone) {
+			failures = append(failures, fmt.Sprintf("Zone '%s' hosts an application excluded by anti-affinity", zone))
+		}
+	}
+	return failures
+}
+
+func capacityFailure(zone string, available, required catalog.Resources) string {
+	return fmt.Sprintf("Zone '%s' lacks capacity: %d CPU and %d GiB RAM available, %d CPU and %d GiB RAM required",
+		zone, available.Cpu, available.Ram, required.Cpu, required.Ram)
+}
@@ -0,0 +1,217 @@
+package opa
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/capacity"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/open-policy-agent/opa/v1/tester"
+)
+
+const policiesDir = "../../policies"
+
+// policyEngine evaluates the sample policies.
+func policyEngine(t *testing.T) *EmbeddedEngine {
+	t.Helper()
+	bundle, err := LoadBundle(policiesDir)
+	if err != nil {
+		t.Fatalf("LoadBundle: %v", err)
+	}
+	engine, err := NewEmbeddedEngine(bundle)
+	if err != nil {
+		t.Fatalf("NewEmbeddedEngine: %v", err)
+	}
+	return engine
+}
+
+func TestTierPolicies(t *testing.T) {
+	zone := func(name, tier, environment string) AvailableZone {
+		return AvailableZone{Name: name, Provider: "default", Labels: map[string]string{"tier": tier, "environment": environment}}
+	}
+	both := []AvailableZone{
+		zone("backup-1", "1", "backup"),
+		zone("backup-2", "2", "backup"),
+		zone("prod-1", "1", "production"),
+		zone("prod-2", "1", "production"),
+		zone("prod-3", "2", "production"),
+	}
+	backupOnly := []AvailableZone{
+		zone("backup-1", "1", "backup"),
+		zone("backup-2", "2", "backup"),
+	}
+	zones := func(zones ...string) *[]string { return &zones }
+
+	tests := []struct {
+		name     string
+		tier     int
+		zones    []AvailableZone
+		input    TierInput
+		valid    bool
+		required []string
+		// candidates are the zones of candidate_zones, required when unset
+		candidates []string
+		failures   []string
+	}{
+		{
+			name:     "tier 1 production zones",
+			tier:     1,
+			zones:    both,
+			input:    TierInput{Name: "web"},
+			valid:    true,
+			required: []string{"prod-1", "prod-2"},
+		},
+		{
+			name:     "tier 1 backup fallback",
+			tier:     1,
+			zones:    backupOnly,
+			input:    TierInput{Name: "web"},
+			valid:    true,
+			required: []string{"backup-1"},
+		},
+		{
+			name:     "tier 1 failover",
+			tier:     1,
+			zones:    both,
+			input:    TierInput{Name: "web", Environment: "backup"},
+			valid:    true,
+			required: []string{"backup-1"},
+		},
+		{
+			name:     "tier 1 missing zone",
+			tier:     1,
+			zones:    both,
+			input:    TierInput{Name: "web", Zones: zones("prod-1")},
+			required: []string{"prod-1", "prod-2"},
+			failures: []string{"Missing required zone 'prod-2' in input specification"},
+		},
+		{
+			name:  "tier 1 saturated zone",
+			tier:  1,
+			zones: both,
+			input: TierInput{
+				Name:         "web",
+				Requirements: catalog.Resources{Cpu: 2, Ram: 2},
+				Capacity: map[string]capacity.Zone{
+					"prod-1": {Capacity: &catalog.Resources{Cpu: 4, Ram: 4}, Available: &catalog.Resources{Cpu: 1, Ram: 4}},
+				},
+			},
+			// Placed in the zone left
+			valid:      true,
+			required:   []string{"prod-1", "prod-2"},
+			candidates: []string{"prod-2"},
+		},
+		{
+			name:  "tier 1 saturated zone requested",
+			tier:  1,
+			zones: both,
+			input: TierInput{
+				Name:         "web",
+				Zones:        zones("prod-1", "prod-2"),
+				Requirements: catalog.Resources{Cpu: 2, Ram: 2},
+				Capacity: map[string]capacity.Zone{
+					"prod-1": {Capacity: &catalog.Resources{Cpu: 4, Ram: 4}, Available: &catalog.Resources{Cpu: 1, Ram: 4}},
+				},
+			},
+			required:   []string{"prod-1", "prod-2"},
+			candidates: []string{"prod-2"},
+			failures:   []string{"Zone 'prod-1' lacks capacity: 1 CPU and 4 GiB RAM available, 2 CPU and 2 GiB RAM required"},
+		},
+		{
+			name:     "tier 2 production zones",
+			tier:     2,
+			zones:    both,
+			input:    TierInput{Name: "web", Zones: zones("prod-3")},
+			valid:    true,
+			required: []string{"prod-3"},
+		},
+		{
+			name:     "tier 2 backup fallback",
+			tier:     2,
+			zones:    backupOnly,
+			input:    TierInput{Name: "web", Zones: zones("prod-3")},
+			required: []string{"backup-2"},
+			failures: []string{
+				"Missing required zone 'backup-2' in input specification",
+				"Unexpected zone 'prod-3' in input specification",
+			},
+		},
+		{
+			name:     "tier 2 failover",
+			tier:     2,
+			zones:    both,
+			input:    TierInput{Name: "web", Zones: zones("prod-3"), Environment: "backup"},
+			required: []string{"backup-2"},
+			failures: []string{
+				"Missing required zone 'backup-2' in input specification",
+				"Unexpected zone 'prod-3' in input specification",
+			},
+		},
+		{
+			name:       "tier 2 without zones",
+			tier:       2,
+			input:      TierInput{Name: "web"},
+			required:   []string{},
+			candidates: []string{},
+			failures:   []string{"No required zone can host the application"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			input.AvailableZones = tt.zones
+			decision, err := policyEngine(t).EvalTierPolicy(context.Background(), TierPolicyPath(tt.tier), input)
+			if err != nil {
+				t.Fatalf("EvalTierPolicy: %v", err)
+			}
+			if IsValid(decision.Result) != tt.valid {
+				t.Fatalf("expected valid to be %t, got %v", tt.valid, decision.Result)
+			}
+			if required := GetRequiredZones(decision.Result); !slices.Equal(required, tt.required) {
+				t.Fatalf("expected required zones %v, got %v", tt.required, required)
+			}
+			candidates := []string{}
+			for _, c := range GetCandidateZones(decision.Result) {
+				if c.Score <= 0 || c.Reason == "" {
+					t.Fatalf("expected candidate %s to be scored, got %+v", c.Zone, c)
+				}
+				candidates = append(candidates, c.Zone)
+			}
+			if tt.candidates == nil && tt.failures == nil {
+				tt.candidates = tt.required
+			}
+			if tt.candidates != nil && !slices.Equal(candidates, tt.candidates) {
+				t.Fatalf("expected candidate zones %v, got %v", tt.candidates, candidates)
+			}
+			// Every candidate zone hosts the application
+			if replicas := GetReplicaZones(decision.Result); replicas != len(candidates) {
+				t.Fatalf("expected %d replica zones, got %d", len(candidates), replicas)
+			}
+			failures := GetFailures(decision.Result)
+			slices.Sort(failures)
+			if !slices.Equal(failures, tt.failures) {
+				t.Fatalf("expected failures %v, got %v", tt.failures, failures)
+			}
+		})
+	}
+}
+
+// TestRegoUnitTests runs the rego tests of the policies, as `opa test policies` does.
+func TestRegoUnitTests(t *testing.T) {
+	results, err := tester.Run(context.Background(), policiesDir)
+	if err != nil {
+		t.Fatalf("running the rego tests: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("expected rego tests in the policies")
+	}
+	for _, result := range results {
+		switch {
+		case result.Error != nil:
+			t.Errorf("%s.%s: %v", result.Package, result.Name, result.Error)
+		case result.Fail:
+			t.Errorf("%s.%s failed", result.Package, result.Name)
+		}
+	}
+}
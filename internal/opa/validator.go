@@ -6,27 +6,184 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dcm-project/dcm-placement-api/internal/capacity"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
 )
 
+// Engine evaluates tier policies for an application. Policies are addressed by their
+// path under data, "tier1" for the package tier1.
+type Engine interface {
+	EvalTierPolicy(ctx context.Context, policy string, input TierInput) (*Decision, error)
+	// PolicyExists reports whether a policy is served at the path
+	PolicyExists(ctx context.Context, policy string) (bool, error)
+}
+
+// policyPath is the syntax of policy paths, package names separated by dots or slashes.
+var policyPath = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*([./][A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// ValidatePolicyPath checks the syntax of a policy path.
+func ValidatePolicyPath(policy string) error {
+	if !policyPath.MatchString(policy) {
+		return fmt.Errorf("invalid policy path %q", policy)
+	}
+	return nil
+}
+
+// TierPolicyPath returns the path of the policy of tier following the tierN convention.
+func TierPolicyPath(tier int) string {
+	return fmt.Sprintf("tier%d", tier)
+}
+
+// TierInput is the input of the tier policies.
+type TierInput struct {
+	Name string `json:"name"`
+	// Zones requested by the user, chosen by the policy when unset
+	Zones   *[]string `json:"zones,omitempty"`
+	Service string    `json:"service,omitempty"`
+	// Requirements are the resources the application reserves in each of its zones
+	Requirements catalog.Resources `json:"requirements"`
+	// Capacity is the usage of the zones known to the capacity ledger
+	Capacity map[string]capacity.Zone `json:"capacity"`
+	// Affinity lists the zones the application must share with the referenced applications
+	Affinity *AffinityInput `json:"affinity,omitempty"`
+	// AntiAffinity lists the zones the application must not share with the referenced applications
+	AntiAffinity *AffinityInput `json:"anti_affinity,omitempty"`
+	// Labels and Annotations of the application, set by the user
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// AvailableZones are the zones of the providers, with the labels of their namespace
+	AvailableZones []AvailableZone `json:"available_zones"`
+	// Environment whose zones the application is placed in: production, or backup once failed over
+	Environment string `json:"environment,omitempty"`
+}
+
+// AvailableZone is a namespace of a provider applications can be placed in.
+type AvailableZone struct {
+	Name     string            `json:"name"`
+	Provider string            `json:"provider"`
+	Labels   map[string]string `json:"labels"`
+}
+
+// Decision is the outcome of the evaluation of a policy along with its provenance.
+type Decision struct {
+	// ID identifies the decision in the OPA decision logs, empty when they are disabled
+	ID string
+	// Revision of the policy bundles that made the decision
+	Revision string
+	Result   map[string]interface{}
+	// Explanation is the trace of the evaluation, when explanations are requested
+	Explanation []map[string]interface{}
+}
+
+// AffinityInput is the placement of the applications referenced by an affinity rule.
+type AffinityInput struct {
+	Applications []string `json:"applications"`
+	Zones        []string `json:"zones"`
+}
+
+// Doer performs HTTP requests.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 type Validator struct {
-	server string
+	server  string
+	client  Doer
+	explain string
+}
+
+var _ Engine = (*Validator)(nil)
+
+func NewValidator(server string, client Doer) *Validator {
+	return &Validator{server: server, client: client}
+}
+
+// Explain modes of OPA, ExplainOff requests no explanation.
+const (
+	ExplainOff   = "off"
+	ExplainNotes = "notes"
+	ExplainFails = "fails"
+	ExplainFull  = "full"
+)
+
+// SetExplain sets the explanation requested with every decision.
+func (v *Validator) SetExplain(mode string) error {
+	switch mode {
+	case "", ExplainOff, ExplainNotes, ExplainFails, ExplainFull:
+		v.explain = mode
+		return nil
+	}
+	return fmt.Errorf("unknown explain mode %q, expected off, notes, fails or full", mode)
+}
+
+func (v *Validator) EvalTierPolicy(ctx context.Context, policy string, input TierInput) (*Decision, error) {
+	return v.evalPolicy(ctx, policy, input)
+}
+
+func (v *Validator) PolicyExists(ctx context.Context, policy string) (bool, error) {
+	url, err := v.dataURL(policy)
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("policy lookup failed with status code: %d", resp.StatusCode)
+	}
+
+	// OPA omits the result of undefined documents
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, err
+	}
+	_, ok := result["result"]
+	return ok, nil
 }
 
-func NewValidator(server string) *Validator {
-	return &Validator{server: server}
+// provenance is the provenance of an OPA response. Revision is set for a single legacy
+// bundle, Bundles for named bundles.
+type provenance struct {
+	Revision string `json:"revision"`
+	Bundles  map[string]struct {
+		Revision string `json:"revision"`
+	} `json:"bundles"`
 }
 
-func (v *Validator) EvalTierPolicy(ctx context.Context, tier int, appName string, zones *[]string) (map[string]interface{}, error) {
-	input := map[string]interface{}{
-		"name": appName,
+// revision combines the revisions of every bundle.
+func (p provenance) revision() string {
+	revisions := []string{}
+	if p.Revision != "" {
+		revisions = append(revisions, p.Revision)
 	}
-	if zones != nil {
-		input["zones"] = zones
+	for name, bundle := range p.Bundles {
+		revisions = append(revisions, name+"="+bundle.Revision)
 	}
-	return v.evalPolicy(ctx, tier, input)
+	sort.Strings(revisions)
+	return strings.Join(revisions, ",")
 }
 
-func (v *Validator) evalPolicy(ctx context.Context, tier int, input interface{}) (map[string]interface{}, error) {
+// dataURL returns the URL of the document of policy in the data API.
+func (v *Validator) dataURL(policy string) (string, error) {
+	if err := ValidatePolicyPath(policy); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/v1/data/%s", v.server, strings.ReplaceAll(policy, ".", "/")), nil
+}
+
+func (v *Validator) evalPolicy(ctx context.Context, policy string, input interface{}) (*Decision, error) {
 	requestBody := map[string]interface{}{
 		"input": input,
 	}
@@ -36,41 +193,125 @@ func (v *Validator) evalPolicy(ctx context.Context, tier int, input interface{})
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/v1/data/tier%d", v.server, tier)
+	url, err := v.dataURL(policy)
+	if err != nil {
+		return nil, err
+	}
+	url += "?provenance=true"
+	if v.explain != "" && v.explain != ExplainOff {
+		url += "&explain=" + v.explain
+	}
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := v.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var result map[string]interface{}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("policy evaluation failed with status code: %d", resp.StatusCode)
+	}
+
+	var result struct {
+		DecisionID  string                   `json:"decision_id"`
+		Provenance  provenance               `json:"provenance"`
+		Result      interface{}              `json:"result"`
+		Explanation []map[string]interface{} `json:"explanation"`
+	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
 
-	return result["result"].(map[string]interface{}), nil
+	decision, ok := result.Result.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("policy %q is not served by OPA", policy)
+	}
+	return &Decision{
+		ID:          result.DecisionID,
+		Revision:    result.Provenance.revision(),
+		Result:      decision,
+		Explanation: result.Explanation,
+	}, nil
 }
 
-func (v *Validator) IsValid(result map[string]interface{}) bool {
-	return result["valid"].(bool)
+func IsValid(result map[string]interface{}) bool {
+	valid, _ := result["valid"].(bool)
+	return valid
 }
 
-func (v *Validator) GetRequiredZones(result map[string]interface{}) []string {
+func GetRequiredZones(result map[string]interface{}) []string {
 	zonesList := []string{}
-	for _, zone := range result["required_zones"].([]interface{}) {
+	zones, _ := result["required_zones"].([]interface{})
+	for _, zone := range zones {
 		zonesList = append(zonesList, zone.(string))
 	}
 	return zonesList
 }
 
-func (v *Validator) GetFailures(result map[string]interface{}) []string {
+// ZoneCandidate is a zone the policy allows, with its score. Higher scores are preferred.
+type ZoneCandidate struct {
+	Zone   string
+	Region string
+	Score  float64
+	Reason string
+}
+
+// GetCandidateZones returns the candidate zones of the result. Policies without
+// candidate_zones make every required zone a candidate with the same score.
+func GetCandidateZones(result map[string]interface{}) []ZoneCandidate {
+	raw, ok := result["candidate_zones"].([]interface{})
+	if !ok {
+		candidates := []ZoneCandidate{}
+		for _, zone := range GetRequiredZones(result) {
+			candidates = append(candidates, ZoneCandidate{Zone: zone, Reason: "required by the tier policy"})
+		}
+		return candidates
+	}
+
+	candidates := make([]ZoneCandidate, 0, len(raw))
+	for _, item := range raw {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		zone, _ := entry["zone"].(string)
+		if zone == "" {
+			continue
+		}
+		candidate := ZoneCandidate{Zone: zone}
+		candidate.Region, _ = entry["region"].(string)
+		candidate.Score = number(entry["score"])
+		candidate.Reason, _ = entry["reason"].(string)
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+// GetReplicaZones returns the number of zones the application should be deployed in,
+// 0 when the policy wants every candidate zone.
+func GetReplicaZones(result map[string]interface{}) int {
+	return int(number(result["replica_zones"]))
+}
+
+// number returns the value of a number of the result, decoded as a float64 from the OPA
+// server and as a json.Number by the embedded engine. Other values are 0.
+func number(value interface{}) float64 {
+	switch n := value.(type) {
+	case float64:
+		return n
+	case json.Number:
+		f, _ := n.Float64()
+		return f
+	}
+	return 0
+}
+
+func GetFailures(result map[string]interface{}) []string {
 	failures, ok := result["failures"]
 	if !ok {
 		return []string{}
@@ -0,0 +1,55 @@
+package opa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidatorDecision(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/data/placement/tier1" {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"provenance": {"bundles": {"placement": {"revision": "r7"}}}}`))
+			return
+		}
+		query = r.URL.RawQuery
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"decision_id": "d-1",
+			"provenance":  map[string]interface{}{"bundles": map[string]interface{}{"placement": map[string]interface{}{"revision": "r7"}}},
+			"result":      map[string]interface{}{"valid": true},
+			"explanation": []interface{}{map[string]interface{}{"op": "eval"}},
+		})
+	}))
+	defer server.Close()
+
+	v := NewValidator(server.URL, server.Client())
+	if err := v.SetExplain("verbose"); err == nil {
+		t.Fatal("expected an unknown explain mode to be rejected")
+	}
+	if err := v.SetExplain(ExplainFails); err != nil {
+		t.Fatalf("SetExplain: %v", err)
+	}
+
+	decision, err := v.EvalTierPolicy(context.Background(), "placement.tier1", TierInput{Name: "web"})
+	if err != nil {
+		t.Fatalf("EvalTierPolicy: %v", err)
+	}
+	if query != "provenance=true&explain=fails" {
+		t.Fatalf("unexpected query %q", query)
+	}
+	if decision.ID != "d-1" || decision.Revision != "placement=r7" || !IsValid(decision.Result) || len(decision.Explanation) != 1 {
+		t.Fatalf("unexpected decision %+v", decision)
+	}
+
+	exists, err := v.PolicyExists(context.Background(), "tier9")
+	if err != nil || exists {
+		t.Fatalf("expected an undefined policy not to exist, got %v, %v", exists, err)
+	}
+	if _, err := v.EvalTierPolicy(context.Background(), "tier9", TierInput{}); err == nil {
+		t.Fatal("expected the evaluation of an undefined policy to fail")
+	}
+}
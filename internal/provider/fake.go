@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/google/uuid"
+)
+
+// FakeOptions configures the behaviour of a FakeProvider.
+type FakeOptions struct {
+	// Latency is added to every call.
+	Latency time.Duration
+	// FailureRate is the probability (0..1) that a call fails with an internal error.
+	FailureRate float64
+	// ReadyAfter is the time a deployment stays pending before turning running.
+	ReadyAfter time.Duration
+	// Namespaces are the namespaces listed by the provider.
+	Namespaces []Namespace
+}
+
+type fakeDeployment struct {
+	resp    DeploymentResponse
+	created time.Time
+	phase   *DeploymentStatusPhase
+}
+
+// FakeProvider is an in-memory Provider for development and tests.
+type FakeProvider struct {
+	mu          sync.Mutex
+	opts        FakeOptions
+	deployments map[string]*fakeDeployment
+	namespaces  []Namespace
+	failNext    map[string]error
+	rand        *rand.Rand
+}
+
+var (
+	_ Provider        = (*FakeProvider)(nil)
+	_ NamespaceLister = (*FakeProvider)(nil)
+)
+
+func NewFakeProvider(opts FakeOptions) *FakeProvider {
+	return &FakeProvider{
+		opts:        opts,
+		deployments: map[string]*fakeDeployment{},
+		namespaces:  opts.Namespaces,
+		failNext:    map[string]error{},
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// FailNext makes the next call of operation ("create", "get", "update", "delete", "list" or
+// "namespaces") return err.
+func (f *FakeProvider) FailNext(operation string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failNext[operation] = err
+}
+
+// SetPhase forces the phase of a deployment, overriding the automatic transition.
+func (f *FakeProvider) SetPhase(deploymentID string, phase DeploymentStatusPhase) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d, ok := f.deployments[deploymentID]
+	if !ok {
+		return fmt.Errorf("deployment not found: %s", deploymentID)
+	}
+	d.phase = &phase
+	return nil
+}
+
+// SetNamespaces replaces the namespaces listed by the provider.
+func (f *FakeProvider) SetNamespaces(namespaces []Namespace) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.namespaces = namespaces
+}
+
+// Count returns the number of deployments currently stored.
+func (f *FakeProvider) Count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.deployments)
+}
+
+func (f *FakeProvider) CreateVMDeployment(ctx context.Context, name, namespace string, vm *catalog.CatalogVm, labels map[string]string) (string, error) {
+	vmSpec := VMSpec{}
+	vmSpec.Vm.Cpu = vm.Cpu
+	vmSpec.Vm.Ram = vm.Ram
+	vmSpec.Vm.Os = VMSpecVmOs(vm.Os)
+
+	var spec DeploymentResponse_Spec
+	if err := spec.FromVMSpec(vmSpec); err != nil {
+		return "", err
+	}
+	return f.create(ctx, DeploymentResponseKindVm, name, namespace, labels, spec, nil)
+}
+
+func (f *FakeProvider) CreateContainerDeployment(ctx context.Context, name, namespace string, app *catalog.ContainerApp, labels map[string]string) (string, error) {
+	replicas := int(app.Replica)
+	containerSpec := ContainerSpec{}
+	containerSpec.Container.Image = app.Image
+	containerSpec.Container.Replicas = &replicas
+
+	var spec DeploymentResponse_Spec
+	if err := spec.FromContainerSpec(containerSpec); err != nil {
+		return "", err
+	}
+	return f.create(ctx, DeploymentResponseKindContainer, name, namespace, labels, spec, &replicas)
+}
+
+func (f *FakeProvider) create(ctx context.Context, kind DeploymentResponseKind, name, namespace string, labels map[string]string, spec DeploymentResponse_Spec, replicas *int) (string, error) {
+	if err := f.call(ctx, "create"); err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := uuid.NewString()
+	now := time.Now()
+	labels = maps.Clone(labels)
+	f.deployments[id] = &fakeDeployment{
+		created: now,
+		resp: DeploymentResponse{
+			Id:        &id,
+			Kind:      &kind,
+			CreatedAt: &now,
+			UpdatedAt: &now,
+			Metadata: &Metadata{
+				Name:      name,
+				Namespace: &namespace,
+				Labels:    &labels,
+			},
+			Spec:   &spec,
+			Status: &DeploymentStatus{ReadyReplicas: replicas},
+		},
+	}
+	return id, nil
+}
+
+func (f *FakeProvider) GetDeployment(ctx context.Context, deploymentID string) (*DeploymentResponse, error) {
+	if err := f.call(ctx, "get"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d, ok := f.deployments[deploymentID]
+	if !ok {
+		return nil, fmt.Errorf("deployment not found: %s", deploymentID)
+	}
+	resp := f.snapshot(d)
+	return &resp, nil
+}
+
+func (f *FakeProvider) UpdateDeployment(ctx context.Context, deploymentID string, req DeploymentRequest) (*DeploymentResponse, error) {
+	if err := f.call(ctx, "update"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d, ok := f.deployments[deploymentID]
+	if !ok {
+		return nil, fmt.Errorf("deployment not found: %s", deploymentID)
+	}
+
+	raw, err := req.Spec.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var spec DeploymentResponse_Spec
+	if err := spec.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	metadata := req.Metadata
+	now := time.Now()
+	d.resp.Spec = &spec
+	d.resp.Metadata = &metadata
+	d.resp.UpdatedAt = &now
+	if container, err := spec.AsContainerSpec(); err == nil && container.Container.Replicas != nil {
+		replicas := *container.Container.Replicas
+		d.resp.Status.ReadyReplicas = &replicas
+	}
+
+	resp := f.snapshot(d)
+	return &resp, nil
+}
+
+func (f *FakeProvider) DeleteDeployment(ctx context.Context, deploymentID string) error {
+	if err := f.call(ctx, "delete"); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.deployments[deploymentID]; !ok {
+		return fmt.Errorf("deployment not found: %s", deploymentID)
+	}
+	delete(f.deployments, deploymentID)
+	return nil
+}
+
+func (f *FakeProvider) ListDeployments(ctx context.Context, params *ListDeploymentsParams) ([]DeploymentResponse, error) {
+	if err := f.call(ctx, "list"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	deployments := []DeploymentResponse{}
+	for _, d := range f.deployments {
+		if params != nil && params.Namespace != nil && *d.resp.Metadata.Namespace != *params.Namespace {
+			continue
+		}
+		if params != nil && params.Kind != nil && string(*d.resp.Kind) != string(*params.Kind) {
+			continue
+		}
+		deployments = append(deployments, f.snapshot(d))
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].CreatedAt.Before(*deployments[j].CreatedAt)
+	})
+	return deployments, nil
+}
+
+func (f *FakeProvider) ListNamespaces(ctx context.Context, labels map[string]string) ([]Namespace, error) {
+	if err := f.call(ctx, "namespaces"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	namespaces := []Namespace{}
+	for _, namespace := range f.namespaces {
+		if matchLabels(namespace.Labels, labels) {
+			namespaces = append(namespaces, Namespace{Name: namespace.Name, Labels: maps.Clone(namespace.Labels)})
+		}
+	}
+	return namespaces, nil
+}
+
+// call applies the configured latency and injected failures.
+func (f *FakeProvider) call(ctx context.Context, operation string) error {
+	if f.opts.Latency > 0 {
+		select {
+		case <-time.After(f.opts.Latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err, ok := f.failNext[operation]; ok {
+		delete(f.failNext, operation)
+		return err
+	}
+	if f.opts.FailureRate > 0 && f.rand.Float64() < f.opts.FailureRate {
+		return fmt.Errorf("internal server error: FAKE - injected %s failure", operation)
+	}
+	return nil
+}
+
+// snapshot returns a copy of the deployment with its current phase.
+func (f *FakeProvider) snapshot(d *fakeDeployment) DeploymentResponse {
+	phase := DeploymentStatusPhasePending
+	if d.phase != nil {
+		phase = *d.phase
+	} else if time.Since(d.created) >= f.opts.ReadyAfter {
+		phase = DeploymentStatusPhaseRunning
+	}
+
+	resp := d.resp
+	status := *d.resp.Status
+	status.Phase = &phase
+	resp.Status = &status
+	return resp
+}
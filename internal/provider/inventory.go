@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Zone is a namespace of a registered provider.
+type Zone struct {
+	Name     string
+	Provider string
+	Labels   map[string]string
+}
+
+// Inventory lists the zones of the registered providers able to list their namespaces. The
+// zones are cached for a TTL, and the last known zones are kept when a provider fails.
+type Inventory struct {
+	registry *Registry
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	zones   []Zone
+	fetched time.Time
+}
+
+func NewInventory(registry *Registry, ttl time.Duration) *Inventory {
+	return &Inventory{registry: registry, ttl: ttl, now: time.Now}
+}
+
+// Zones returns the zones of every provider, sorted by name. A zone listed by several
+// providers belongs to the first one by name. The failing providers keep their last known
+// zones, if any, so they do not hold back the placements in the zones of the others. An
+// error is only returned when no zone is known at all.
+func (i *Inventory) Zones(ctx context.Context) ([]Zone, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.zones != nil && i.now().Sub(i.fetched) < i.ttl {
+		return i.zones, nil
+	}
+
+	zones, err := i.fetch(ctx)
+	var listErr *ListError
+	if errors.As(err, &listErr) {
+		for _, zone := range i.zones {
+			_, failed := listErr.Failed[zone.Provider]
+			if failed && !slices.ContainsFunc(zones, func(z Zone) bool { return z.Name == zone.Name }) {
+				zones = append(zones, zone)
+			}
+		}
+		if len(zones) == 0 {
+			return nil, err
+		}
+		sort.Slice(zones, func(a, b int) bool { return zones[a].Name < zones[b].Name })
+		zap.S().Named("zone_inventory").Warnw("Failed to list the zones of some providers, using their last known ones", "providers", listErr.Providers(), "error", err)
+	} else if err != nil {
+		return nil, err
+	}
+	i.zones, i.fetched = zones, i.now()
+	return zones, nil
+}
+
+// Refresh lists the zones again, bypassing the cache. When some providers fail, the zones of
+// the others are returned along with a *ListError, and the cache is left unchanged.
+func (i *Inventory) Refresh(ctx context.Context) ([]Zone, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	zones, err := i.fetch(ctx)
+	if err == nil {
+		i.zones, i.fetched = zones, i.now()
+	}
+	return zones, err
+}
+
+// Invalidate makes the next call list the zones again.
+func (i *Inventory) Invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.fetched = time.Time{}
+}
+
+// ListError is returned when some providers failed to list their namespaces.
+type ListError struct {
+	// Failed holds the error of every failing provider by name
+	Failed map[string]error
+}
+
+func (e *ListError) Error() string {
+	return errors.Join(e.Unwrap()...).Error()
+}
+
+// Unwrap returns the errors of the failing providers, sorted by provider name.
+func (e *ListError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, name := range e.Providers() {
+		errs = append(errs, fmt.Errorf("failed to list the namespaces of provider %q: %w", name, e.Failed[name]))
+	}
+	return errs
+}
+
+// Providers returns the names of the failing providers, sorted.
+func (e *ListError) Providers() []string {
+	names := make([]string, 0, len(e.Failed))
+	for name := range e.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fetch lists the zones of every provider, skipping the failing ones reported in a *ListError.
+func (i *Inventory) fetch(ctx context.Context) ([]Zone, error) {
+	zones := []Zone{}
+	seen := map[string]string{}
+	failed := map[string]error{}
+	for _, name := range i.registry.Names() {
+		p, err := i.registry.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		lister, ok := p.(NamespaceLister)
+		if !ok {
+			continue
+		}
+		namespaces, err := lister.ListNamespaces(ctx, nil)
+		if err != nil {
+			failed[name] = err
+			continue
+		}
+		for _, namespace := range namespaces {
+			if owner, ok := seen[namespace.Name]; ok {
+				zap.S().Named("zone_inventory").Warnw("Namespace listed by several providers", "zone", namespace.Name, "providers", []string{owner, name})
+				continue
+			}
+			seen[namespace.Name] = name
+			zones = append(zones, Zone{Name: namespace.Name, Provider: name, Labels: namespace.Labels})
+		}
+	}
+	sort.Slice(zones, func(a, b int) bool { return zones[a].Name < zones[b].Name })
+	if len(failed) > 0 {
+		return zones, &ListError{Failed: failed}
+	}
+	return zones, nil
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// namespaceStub serves POST /namespaces like the k8s-service-provider, returning the
+// namespaces carrying every requested label. It counts the requests it serves.
+func namespaceStub(t *testing.T, namespaces []Namespace, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/namespaces" {
+			http.NotFound(w, r)
+			return
+		}
+		requests.Add(1)
+		var request namespacesRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response := namespacesResponse{Namespaces: []Namespace{}}
+		for _, namespace := range namespaces {
+			if matchLabels(namespace.Labels, request.Labels) {
+				response.Namespaces = append(response.Namespaces, namespace)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListNamespaces(t *testing.T) {
+	var requests atomic.Int32
+	stub := namespaceStub(t, []Namespace{
+		{Name: "prod-1", Labels: map[string]string{"environment": "production"}},
+		{Name: "backup-1", Labels: map[string]string{"environment": "backup"}},
+	}, &requests)
+	svc, err := NewService(stub.URL + "/api/v1")
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	namespaces, err := svc.ListNamespaces(context.Background(), map[string]string{"environment": "backup"})
+	if err != nil {
+		t.Fatalf("ListNamespaces: %v", err)
+	}
+	if len(namespaces) != 1 || namespaces[0].Name != "backup-1" || namespaces[0].Labels["environment"] != "backup" {
+		t.Fatalf("expected the backup namespace, got %+v", namespaces)
+	}
+}
+
+func TestInventory(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	stub := namespaceStub(t, []Namespace{
+		{Name: "zone-b", Labels: map[string]string{"tier": "1"}},
+		{Name: "zone-a", Labels: map[string]string{"tier": "1"}},
+	}, &requests)
+	svc, err := NewService(stub.URL + "/api/v1")
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	fake := NewFakeProvider(FakeOptions{Namespaces: []Namespace{{Name: "zone-a"}, {Name: "zone-c"}}})
+
+	registry := NewRegistry()
+	if err := registry.Register("k8s", svc, nil, nil, true); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := registry.Register("other", fake, nil, nil, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	inventory := NewInventory(registry, time.Minute)
+	now := time.Now()
+	inventory.now = func() time.Time { return now }
+
+	zones, err := inventory.Zones(ctx)
+	if err != nil {
+		t.Fatalf("Zones: %v", err)
+	}
+	var names, providers []string
+	for _, zone := range zones {
+		names = append(names, zone.Name)
+		providers = append(providers, zone.Provider)
+	}
+	// zone-a is listed by both providers and belongs to the first one by name
+	if !slices.Equal(names, []string{"zone-a", "zone-b", "zone-c"}) || !slices.Equal(providers, []string{"k8s", "k8s", "other"}) {
+		t.Fatalf("unexpected zones %v of providers %v", names, providers)
+	}
+
+	if _, err := inventory.Zones(ctx); err != nil || requests.Load() != 1 {
+		t.Fatalf("expected the zones to be cached, got %d requests and %v", requests.Load(), err)
+	}
+
+	// The last known zones are kept when a provider fails
+	now = now.Add(2 * time.Minute)
+	fake.FailNext("namespaces", errors.New("unavailable"))
+	zones, err = inventory.Zones(ctx)
+	if err != nil || len(zones) != 3 || requests.Load() != 2 {
+		t.Fatalf("expected the last known zones after a failed refresh, got %v, %d requests and %v", zones, requests.Load(), err)
+	}
+
+	// A failing provider does not hide the zones of the others, even before any were listed
+	partial := NewInventory(registry, time.Minute)
+	fake.FailNext("namespaces", errors.New("unavailable"))
+	zones, err = partial.Zones(ctx)
+	if err != nil {
+		t.Fatalf("Zones: %v", err)
+	}
+	names = nil
+	for _, zone := range zones {
+		names = append(names, zone.Name)
+	}
+	if !slices.Equal(names, []string{"zone-a", "zone-b"}) {
+		t.Fatalf("expected the zones of the healthy provider, got %v", names)
+	}
+}
+
+func TestInventoryWithoutZones(t *testing.T) {
+	fake := NewFakeProvider(FakeOptions{Namespaces: []Namespace{{Name: "zone-a"}}})
+	registry := NewRegistry()
+	if err := registry.Register("fake", fake, nil, nil, true); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	fake.FailNext("namespaces", errors.New("unavailable"))
+	_, err := NewInventory(registry, time.Minute).Zones(context.Background())
+	var listErr *ListError
+	if !errors.As(err, &listErr) || !slices.Equal(listErr.Providers(), []string{"fake"}) {
+		t.Fatalf("expected a list error when no zone was ever listed, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Namespace is a namespace of a provider, which applications can be placed in as a zone.
+type Namespace struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// NamespaceLister is implemented by the providers able to list their namespaces.
+type NamespaceLister interface {
+	// ListNamespaces returns the namespaces carrying every label of labels, all of them
+	// when labels is empty.
+	ListNamespaces(ctx context.Context, labels map[string]string) ([]Namespace, error)
+}
+
+var _ NamespaceLister = (*Service)(nil)
+
+type namespacesRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+type namespacesResponse struct {
+	Namespaces []Namespace `json:"namespaces"`
+}
+
+// ListNamespaces queries POST /namespaces of the provider service. The endpoint is not part
+// of the generated client, so the request goes through its HTTP client and request editors.
+func (s *Service) ListNamespaces(ctx context.Context, labels map[string]string) ([]Namespace, error) {
+	c, ok := s.client.ClientInterface.(*Client)
+	if !ok {
+		return nil, fmt.Errorf("provider client does not support namespaces")
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	body, err := json.Marshal(namespacesRequest{Labels: labels})
+	if err != nil {
+		return nil, err
+	}
+	// The generated client guarantees Server ends with a slash
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Server+"namespaces", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := c.applyEditors(ctx, req, nil); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	var result namespacesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode namespaces: %w", err)
+	}
+	if result.Namespaces == nil {
+		return []Namespace{}, nil
+	}
+	return result.Namespaces, nil
+}
+
+// matchLabels reports whether labels carry every label of selector.
+func matchLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
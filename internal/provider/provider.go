@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+)
+
+// Provider is a service provider able to run deployments in one or more zones.
+type Provider interface {
+	CreateVMDeployment(ctx context.Context, name, namespace string, vm *catalog.CatalogVm, labels map[string]string) (string, error)
+	CreateContainerDeployment(ctx context.Context, name, namespace string, app *catalog.ContainerApp, labels map[string]string) (string, error)
+	GetDeployment(ctx context.Context, deploymentID string) (*DeploymentResponse, error)
+	UpdateDeployment(ctx context.Context, deploymentID string, req DeploymentRequest) (*DeploymentResponse, error)
+	DeleteDeployment(ctx context.Context, deploymentID string) error
+	ListDeployments(ctx context.Context, params *ListDeploymentsParams) ([]DeploymentResponse, error)
+}
+
+var _ Provider = (*Service)(nil)
+
+const (
+	// AppIDLabel is set on every deployment to the ID of its application
+	AppIDLabel = "app-id"
+	// ReservedLabelPrefix is reserved to the labels set by the placement service
+	ReservedLabelPrefix = "dcm.io/"
+)
+
+// IsReservedLabel reports whether key is set by the placement service and cannot be
+// given by users.
+func IsReservedLabel(key string) bool {
+	return key == AppIDLabel || strings.HasPrefix(key, ReservedLabelPrefix)
+}
+
+// DeploymentLabels returns the labels of the deployments of an application: the labels of
+// the application, which cannot override the reserved ones, and the application ID.
+func DeploymentLabels(appID string, labels map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range labels {
+		if !IsReservedLabel(key) {
+			result[key] = value
+		}
+	}
+	result[AppIDLabel] = appID
+	return result
+}
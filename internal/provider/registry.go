@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/dcm-project/dcm-placement-api/internal/httpclient"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProviderName is the name given to the provider built from PROVIDER_SERVICE_URL
+// when no providers configuration file is set.
+const DefaultProviderName = "default"
+
+// ProviderConfig describes a single provider entry of the providers configuration file.
+type ProviderConfig struct {
+	Name    string   `yaml:"name"`
+	URL     string   `yaml:"url"`
+	Kinds   []string `yaml:"kinds"`
+	Zones   []string `yaml:"zones"`
+	Default bool     `yaml:"default"`
+}
+
+type RegistryConfig struct {
+	Providers []ProviderConfig `yaml:"providers"`
+}
+
+type registryEntry struct {
+	name     string
+	provider Provider
+	kinds    []string
+}
+
+// Registry holds the named providers and maps every zone to the provider serving it.
+type Registry struct {
+	providers   map[string]*registryEntry
+	zones       map[string]string
+	defaultName string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: map[string]*registryEntry{},
+		zones:     map[string]string{},
+	}
+}
+
+// NewRegistryFromConfig builds a registry from the providers configuration file.
+// When path is empty a single default provider pointing at defaultURL is registered,
+// serving every zone and kind.
+// Every provider gets its own resilient HTTP client configured with httpCfg.
+func NewRegistryFromConfig(path string, defaultURL string, httpCfg httpclient.Config) (*Registry, error) {
+	cfg := RegistryConfig{
+		Providers: []ProviderConfig{{Name: DefaultProviderName, URL: defaultURL, Default: true}},
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read providers config: %w", err)
+		}
+		cfg = RegistryConfig{}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse providers config: %w", err)
+		}
+	}
+
+	r := NewRegistry()
+	for _, pc := range cfg.Providers {
+		svc, err := NewService(pc.URL, WithHTTPClient(httpclient.New("provider:"+pc.Name, httpCfg)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize provider %q: %w", pc.Name, err)
+		}
+		if err := r.Register(pc.Name, svc, pc.Kinds, pc.Zones, pc.Default); err != nil {
+			return nil, err
+		}
+	}
+	if len(r.providers) == 0 {
+		return nil, fmt.Errorf("no providers configured")
+	}
+	return r, nil
+}
+
+// Register adds a provider to the registry. An empty kinds list means the provider
+// supports every kind. Zones are mapped to this provider exclusively.
+func (r *Registry) Register(name string, p Provider, kinds []string, zones []string, isDefault bool) error {
+	if name == "" {
+		return fmt.Errorf("provider name is required")
+	}
+	if _, ok := r.providers[name]; ok {
+		return fmt.Errorf("provider %q is already registered", name)
+	}
+	for _, zone := range zones {
+		if owner, ok := r.zones[zone]; ok {
+			return fmt.Errorf("zone %q is served by both %q and %q", zone, owner, name)
+		}
+	}
+
+	r.providers[name] = &registryEntry{name: name, provider: p, kinds: kinds}
+	for _, zone := range zones {
+		r.zones[zone] = name
+	}
+	if isDefault || r.defaultName == "" {
+		r.defaultName = name
+	}
+
+	zap.S().Named("provider_registry").Infow("Registered provider", "name", name, "kinds", kinds, "zones", zones)
+	return nil
+}
+
+// Get returns the provider registered under name.
+func (r *Registry) Get(name string) (Provider, error) {
+	entry, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown provider %q", name)
+	}
+	return entry.provider, nil
+}
+
+// ForZone returns the provider serving zone for the given deployment kind.
+// Zones not explicitly mapped are served by the default provider.
+func (r *Registry) ForZone(zone string, kind DeploymentRequestKind) (string, Provider, error) {
+	name, ok := r.zones[zone]
+	if !ok {
+		name = r.defaultName
+	}
+	return r.serving(name, zone, kind)
+}
+
+// ForListedZone returns the provider serving zone for the given deployment kind, zone being
+// a namespace listed by the provider named owner. Zones mapped to another provider are rejected.
+func (r *Registry) ForListedZone(zone, owner string, kind DeploymentRequestKind) (string, Provider, error) {
+	if name, ok := r.zones[zone]; ok && name != owner {
+		return "", nil, fmt.Errorf("zone %q is mapped to provider %q but listed by provider %q", zone, name, owner)
+	}
+	return r.serving(owner, zone, kind)
+}
+
+// serving returns the provider named name when it supports kind.
+func (r *Registry) serving(name, zone string, kind DeploymentRequestKind) (string, Provider, error) {
+	entry, ok := r.providers[name]
+	if !ok {
+		return "", nil, fmt.Errorf("no provider serves zone %q", zone)
+	}
+	if len(entry.kinds) > 0 && !slices.Contains(entry.kinds, string(kind)) {
+		return "", nil, fmt.Errorf("provider %q serving zone %q does not support kind %q", name, zone, kind)
+	}
+	return name, entry.provider, nil
+}
+
+// Names returns the registered provider names, sorted.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
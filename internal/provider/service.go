@@ -14,8 +14,8 @@ type Service struct {
 	logger *zap.SugaredLogger
 }
 
-func NewService(baseURL string) (*Service, error) {
-	client, err := NewClientWithResponses(baseURL)
+func NewService(baseURL string, opts ...ClientOption) (*Service, error) {
+	client, err := NewClientWithResponses(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create provider client: %w", err)
 	}
@@ -27,14 +27,11 @@ func NewService(baseURL string) (*Service, error) {
 }
 
 // CreateVMDeployment creates a VM deployment in the provider service
-func (s *Service) CreateVMDeployment(ctx context.Context, name, namespace string, vm *catalog.CatalogVm, appID string) (string, error) {
+func (s *Service) CreateVMDeployment(ctx context.Context, name, namespace string, vm *catalog.CatalogVm, labels map[string]string) (string, error) {
 	s.logger.Infow("Creating VM deployment", "name", name, "namespace", namespace)
 
 	// Build the deployment request
 	kind := DeploymentRequestKindVm
-	labels := map[string]string{
-		"app-id": appID,
-	}
 
 	vmSpec := VMSpec{
 		Vm: struct {
@@ -90,15 +87,10 @@ func (s *Service) CreateVMDeployment(ctx context.Context, name, namespace string
 	return *resp.JSON201.Id, nil
 }
 
-// CreateContainerDeployment creates a container deployment in the provider service
-func (s *Service) CreateContainerDeployment(ctx context.Context, name, namespace string, app *catalog.ContainerApp, appID string) (string, error) {
-	s.logger.Infow("Creating container deployment", "name", name, "namespace", namespace)
-
-	// Build the deployment request
+// ContainerDeploymentRequest returns the request deploying app in namespace, used to create
+// container deployments and to update their replicas
+func ContainerDeploymentRequest(name, namespace string, app *catalog.ContainerApp, labels map[string]string) (DeploymentRequest, error) {
 	kind := DeploymentRequestKindContainer
-	labels := map[string]string{
-		"app-id": appID,
-	}
 
 	replicas := int(app.Replica)
 	containerPort := app.Port
@@ -141,10 +133,10 @@ func (s *Service) CreateContainerDeployment(ctx context.Context, name, namespace
 
 	var spec DeploymentRequest_Spec
 	if err := spec.FromContainerSpec(containerSpec); err != nil {
-		return "", fmt.Errorf("failed to create spec from ContainerSpec: %w", err)
+		return DeploymentRequest{}, fmt.Errorf("failed to create spec from ContainerSpec: %w", err)
 	}
 
-	req := CreateDeploymentJSONRequestBody{
+	return DeploymentRequest{
 		Kind: kind,
 		Metadata: Metadata{
 			Name:      name,
@@ -152,6 +144,16 @@ func (s *Service) CreateContainerDeployment(ctx context.Context, name, namespace
 			Labels:    &labels,
 		},
 		Spec: spec,
+	}, nil
+}
+
+// CreateContainerDeployment creates a container deployment in the provider service
+func (s *Service) CreateContainerDeployment(ctx context.Context, name, namespace string, app *catalog.ContainerApp, labels map[string]string) (string, error) {
+	s.logger.Infow("Creating container deployment", "name", name, "namespace", namespace)
+
+	req, err := ContainerDeploymentRequest(name, namespace, app, labels)
+	if err != nil {
+		return "", err
 	}
 
 	// Call the provider service
@@ -203,3 +205,76 @@ func (s *Service) DeleteDeployment(ctx context.Context, deploymentID string) err
 	s.logger.Infow("Deployment deleted successfully", "deploymentID", deploymentID)
 	return nil
 }
+
+// GetDeployment returns a deployment by ID
+func (s *Service) GetDeployment(ctx context.Context, deploymentID string) (*DeploymentResponse, error) {
+	resp, err := s.client.GetDeploymentWithResponse(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		if resp.JSON404 != nil {
+			return nil, fmt.Errorf("deployment not found: %s - %s", resp.JSON404.Code, resp.JSON404.Message)
+		}
+		if resp.JSON500 != nil {
+			return nil, fmt.Errorf("internal server error: %s - %s", resp.JSON500.Code, resp.JSON500.Message)
+		}
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("deployment %s returned no body", deploymentID)
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateDeployment replaces the spec of an existing deployment
+func (s *Service) UpdateDeployment(ctx context.Context, deploymentID string, req DeploymentRequest) (*DeploymentResponse, error) {
+	s.logger.Infow("Updating deployment", "deploymentID", deploymentID)
+
+	resp, err := s.client.UpdateDeploymentWithResponse(ctx, deploymentID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update deployment: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		if resp.JSON400 != nil {
+			return nil, fmt.Errorf("bad request: %s - %s", resp.JSON400.Code, resp.JSON400.Message)
+		}
+		if resp.JSON404 != nil {
+			return nil, fmt.Errorf("deployment not found: %s - %s", resp.JSON404.Code, resp.JSON404.Message)
+		}
+		if resp.JSON500 != nil {
+			return nil, fmt.Errorf("internal server error: %s - %s", resp.JSON500.Code, resp.JSON500.Message)
+		}
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("deployment %s updated but no body returned", deploymentID)
+	}
+
+	s.logger.Infow("Deployment updated successfully", "deploymentID", deploymentID)
+	return resp.JSON200, nil
+}
+
+// ListDeployments returns the deployments matching the given filter
+func (s *Service) ListDeployments(ctx context.Context, params *ListDeploymentsParams) ([]DeploymentResponse, error) {
+	resp, err := s.client.ListDeploymentsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		if resp.JSON500 != nil {
+			return nil, fmt.Errorf("internal server error: %s - %s", resp.JSON500.Code, resp.JSON500.Message)
+		}
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Deployments == nil {
+		return []DeploymentResponse{}, nil
+	}
+	return *resp.JSON200.Deployments, nil
+}
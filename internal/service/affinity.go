@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+// selectorFromAPI converts an affinity rule of a request, nil when unset.
+func selectorFromAPI(selector *server.ApplicationSelector) *model.ApplicationSelector {
+	if selector == nil {
+		return nil
+	}
+	result := &model.ApplicationSelector{}
+	if selector.ApplicationIds != nil {
+		result.ApplicationIDs = *selector.ApplicationIds
+	}
+	if selector.MatchLabels != nil {
+		result.MatchLabels = *selector.MatchLabels
+	}
+	return result
+}
+
+// affinityInputs resolves the applications referenced by the affinity rules of the request
+// and the zones they are placed in. Anti-affinity goes both ways: the applications whose
+// anti-affinity matches the application id described by request are kept apart from it too.
+// The application id itself is left out.
+func (s *PlacementService) affinityInputs(ctx context.Context, request *server.CreateApplicationJSONRequestBody, id uuid.UUID) (*opa.AffinityInput, *opa.AffinityInput, error) {
+	affinity := selectorFromAPI(request.Affinity)
+	antiAffinity := selectorFromAPI(request.AntiAffinity)
+	candidate := model.Application{ID: id, Labels: optionalMap(request.Labels)}
+
+	var affinityInput, antiAffinityInput *opa.AffinityInput
+	if affinity != nil {
+		affinityInput = &opa.AffinityInput{Applications: []string{}, Zones: []string{}}
+	}
+	if antiAffinity != nil {
+		antiAffinityInput = &opa.AffinityInput{Applications: []string{}, Zones: []string{}}
+	}
+	err := s.eachApplication(ctx, func(app model.Application) {
+		if app.ID == id {
+			return
+		}
+		if affinity != nil && affinity.Matches(app) {
+			addAffinity(affinityInput, app)
+		}
+		repelled := app.AntiAffinity != nil && app.AntiAffinity.Matches(candidate)
+		if (antiAffinity != nil && antiAffinity.Matches(app)) || repelled {
+			if antiAffinityInput == nil {
+				antiAffinityInput = &opa.AffinityInput{Applications: []string{}, Zones: []string{}}
+			}
+			addAffinity(antiAffinityInput, app)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return affinityInput, antiAffinityInput, nil
+}
+
+func addAffinity(input *opa.AffinityInput, app model.Application) {
+	input.Applications = append(input.Applications, app.ID.String())
+	for _, zone := range app.Zones {
+		if !slices.Contains(input.Zones, zone) {
+			input.Zones = append(input.Zones, zone)
+		}
+	}
+}
+
+func optionalMap(m *map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	return *m
+}
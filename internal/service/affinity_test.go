@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestCreateApplicationAffinity(t *testing.T) {
+	ctx := context.Background()
+	ps, s, _ := newTestService(t)
+
+	tier1, tier2 := 1, 2
+	labels := map[string]string{"role": "cache"}
+	cache, err := ps.CreateApplication(ctx, &server.Application{Name: "cache", Service: server.Container, Tier: &tier2, Labels: &labels}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *cache.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if stored.Labels["role"] != "cache" {
+		t.Fatalf("expected labels to be stored, got %v", stored.Labels)
+	}
+
+	withCache := &server.ApplicationSelector{MatchLabels: &labels}
+	ids := []openapi_types.UUID{*cache.Id}
+	awayFromCache := &server.ApplicationSelector{ApplicationIds: &ids}
+	missing := map[string]string{"role": "db"}
+
+	tests := []struct {
+		name    string
+		app     server.Application
+		failure string
+	}{
+		{
+			name: "co-located",
+			app:  server.Application{Name: "web", Service: server.Container, Tier: &tier2, Affinity: withCache},
+		},
+		{
+			name:    "not co-located",
+			app:     server.Application{Name: "web", Service: server.Container, Tier: &tier1, Affinity: withCache},
+			failure: "Zone 'zone-a' hosts none of the applications required by affinity",
+		},
+		{
+			name:    "no application matched",
+			app:     server.Application{Name: "web", Service: server.Container, Tier: &tier2, Affinity: &server.ApplicationSelector{MatchLabels: &missing}},
+			failure: "Affinity matches no application",
+		},
+		{
+			name: "apart",
+			app:  server.Application{Name: "web", Service: server.Container, Tier: &tier1, AntiAffinity: awayFromCache},
+		},
+		{
+			name:    "sharing a zone",
+			app:     server.Application{Name: "web", Service: server.Container, Tier: &tier2, AntiAffinity: awayFromCache},
+			failure: "Zone 'zone-c' hosts an application excluded by anti-affinity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ps.CreateApplication(ctx, &tt.app, "", CreateOptions{})
+			if tt.failure == "" {
+				if err != nil {
+					t.Fatalf("CreateApplication: %v", err)
+				}
+				return
+			}
+			var rejected *PolicyRejectedError
+			if !errors.As(err, &rejected) || !strings.Contains(rejected.Reason, tt.failure) {
+				t.Fatalf("expected failure %q, got %v", tt.failure, err)
+			}
+		})
+	}
+}
+
+func TestAntiAffinityBothWays(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestService(t)
+
+	tier1, tier2 := 1, 2
+	web := map[string]string{"role": "web"}
+	worker := map[string]string{"role": "worker"}
+	// The database keeps the web applications away, they do not set anti-affinity themselves
+	awayFromWeb := &server.ApplicationSelector{MatchLabels: &web}
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "db", Service: server.Container, Tier: &tier2, AntiAffinity: awayFromWeb}, "", CreateOptions{}); err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	var rejected *PolicyRejectedError
+	_, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier2, Labels: &web}, "", CreateOptions{})
+	if !errors.As(err, &rejected) || !strings.Contains(rejected.Reason, "Zone 'zone-c' hosts an application excluded by anti-affinity") {
+		t.Fatalf("expected the web application to be kept away from the database, got %v", err)
+	}
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "worker", Service: server.Container, Tier: &tier2, Labels: &worker}, "", CreateOptions{}); err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	// Relocations keep the applications apart too
+	frontend, err := ps.CreateApplication(ctx, &server.Application{Name: "frontend", Service: server.Container, Tier: &tier1, Labels: &web}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-c"})
+	_, err = ps.Failover(ctx, *frontend.Id, FailoverOptions{})
+	if !errors.As(err, &rejected) || !strings.Contains(rejected.Reason, "Zone 'zone-c' hosts an application excluded by anti-affinity") {
+		t.Fatalf("expected the failover next to the database to be rejected, got %v", err)
+	}
+}
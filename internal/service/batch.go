@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DefaultBatchConcurrency is the number of items of a batch processed in parallel by default.
+const DefaultBatchConcurrency = 4
+
+// Batch item statuses.
+const (
+	BatchItemSucceeded  = "succeeded"
+	BatchItemFailed     = "failed"
+	BatchItemRolledBack = "rolled_back"
+	BatchItemSkipped    = "skipped"
+)
+
+// errBatchAborted is reported for the items of an atomic batch that were not processed
+// because another item failed.
+var errBatchAborted = errors.New("not processed, another item of the atomic batch failed")
+
+// BatchOptions controls how a batch is processed.
+type BatchOptions struct {
+	// Atomic processes the batch all-or-nothing, otherwise every item succeeds or fails on its own
+	Atomic bool
+	// Concurrency bounds the items processed in parallel, defaults to DefaultBatchConcurrency
+	Concurrency int
+}
+
+// BatchCreateItem is an application to create, with an optional ID.
+type BatchCreateItem struct {
+	ID          string
+	Application *server.Application
+}
+
+// BatchResult is the outcome of one item of a batch.
+type BatchResult struct {
+	ID          uuid.UUID
+	Status      string
+	Err         error
+	Application *server.ApplicationResponse
+}
+
+// BatchCreateApplications creates the applications with bounded concurrency.
+// In atomic mode the policy and the quotas of every item are evaluated before anything is
+// created, each item counting the ones accepted before it, and the applications already
+// created are deleted when one of them fails.
+func (s *PlacementService) BatchCreateApplications(ctx context.Context, items []BatchCreateItem, opts BatchOptions) []BatchResult {
+	logger := zap.S().Named("placement_service:batch_create")
+	results := make([]BatchResult, len(items))
+
+	if !opts.Atomic {
+		forEach(len(items), opts.Concurrency, func(i int) {
+			app, err := s.CreateApplication(ctx, items[i].Application, items[i].ID, CreateOptions{})
+			results[i] = batchResult(app, err)
+		})
+		return results
+	}
+
+	// Evaluate every item before creating anything
+	placements := make([]*placement, len(items))
+	forEach(len(items), opts.Concurrency, func(i int) {
+		p, err := s.place(ctx, items[i].Application, items[i].ID, nil)
+		placements[i] = p
+		if err != nil {
+			results[i] = BatchResult{Status: BatchItemFailed, Err: err}
+		}
+	})
+	if err := duplicateIDs(placements); err != nil {
+		return abortBatch(results, err)
+	}
+	if failed(results) {
+		return abortBatch(results, errBatchAborted)
+	}
+	var accepted []model.Application
+	for i, p := range placements {
+		app := p.application(items[i].Application)
+		if err := s.checkQuotas(ctx, app, accepted...); err != nil {
+			s.recordEvent(ctx, p.id, EventQuotaExceeded, err.Error())
+			results[i] = BatchResult{Status: BatchItemFailed, Err: err}
+			return abortBatch(results, errBatchAborted)
+		}
+		accepted = append(accepted, app)
+	}
+
+	forEach(len(items), opts.Concurrency, func(i int) {
+		app, err := s.deploy(ctx, items[i].Application, placements[i], CreateOptions{})
+		results[i] = batchResult(app, err)
+		results[i].ID = placements[i].id
+	})
+	if !failed(results) {
+		return results
+	}
+
+	logger.Warnw("Atomic batch failed, deleting the applications created", "items", len(items))
+	forEach(len(items), opts.Concurrency, func(i int) {
+		if results[i].Status != BatchItemSucceeded {
+			return
+		}
+		if _, err := s.DeleteApplication(ctx, results[i].ID); err != nil {
+			logger.Warnw("Failed to roll back application", "application", results[i].ID, "error", err)
+			results[i].Err = fmt.Errorf("rollback failed: %w", err)
+			return
+		}
+		results[i].Status = BatchItemRolledBack
+		results[i].Err = errBatchAborted
+	})
+	return results
+}
+
+// BatchDeleteApplications deletes the applications with bounded concurrency.
+// In atomic mode nothing is deleted unless every application exists. Deletions that
+// started cannot be undone.
+func (s *PlacementService) BatchDeleteApplications(ctx context.Context, ids []uuid.UUID, opts BatchOptions) []BatchResult {
+	results := make([]BatchResult, len(ids))
+
+	if opts.Atomic {
+		seen := map[uuid.UUID]bool{}
+		for i, id := range ids {
+			results[i].ID = id
+			if seen[id] {
+				results[i].Status = BatchItemFailed
+				results[i].Err = fmt.Errorf("application %s appears more than once", id)
+				continue
+			}
+			seen[id] = true
+			if _, err := s.store.Application().Get(ctx, id); err != nil {
+				results[i].Status = BatchItemFailed
+				results[i].Err = fmt.Errorf("application %s: %w", id, err)
+			}
+		}
+		if failed(results) {
+			return abortBatch(results, errBatchAborted)
+		}
+	}
+
+	forEach(len(ids), opts.Concurrency, func(i int) {
+		app, err := s.DeleteApplication(ctx, ids[i])
+		results[i] = batchResult(app, err)
+		results[i].ID = ids[i]
+	})
+	return results
+}
+
+func batchResult(app *server.ApplicationResponse, err error) BatchResult {
+	if err != nil {
+		return BatchResult{Status: BatchItemFailed, Err: err}
+	}
+	result := BatchResult{Status: BatchItemSucceeded, Application: app}
+	if app.Id != nil {
+		result.ID = *app.Id
+	}
+	return result
+}
+
+// abortBatch marks the items that did not fail as skipped because of err.
+func abortBatch(results []BatchResult, err error) []BatchResult {
+	for i := range results {
+		if results[i].Status != BatchItemFailed {
+			results[i].Status = BatchItemSkipped
+			results[i].Err = err
+		}
+	}
+	return results
+}
+
+func failed(results []BatchResult) bool {
+	for _, r := range results {
+		if r.Status == BatchItemFailed {
+			return true
+		}
+	}
+	return false
+}
+
+func duplicateIDs(placements []*placement) error {
+	seen := map[uuid.UUID]bool{}
+	for _, p := range placements {
+		if p == nil {
+			continue
+		}
+		if seen[p.id] {
+			return fmt.Errorf("application %s appears more than once", p.id)
+		}
+		seen[p.id] = true
+	}
+	return nil
+}
+
+// forEach calls fn for every index in [0, n) with at most concurrency calls in parallel.
+func forEach(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/google/uuid"
+)
+
+func batchItems(zones ...[]string) []BatchCreateItem {
+	items := make([]BatchCreateItem, 0, len(zones))
+	for _, z := range zones {
+		app := &server.Application{Name: "web", Service: server.Container}
+		if z != nil {
+			app.Zones = &z
+		}
+		items = append(items, BatchCreateItem{Application: app})
+	}
+	return items
+}
+
+func statuses(results []BatchResult) []string {
+	out := make([]string, 0, len(results))
+	for _, r := range results {
+		out = append(out, r.Status)
+	}
+	return out
+}
+
+func expectStatuses(t *testing.T, results []BatchResult, expected ...string) {
+	t.Helper()
+	got := statuses(results)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestBatchCreateIndependent(t *testing.T) {
+	ps, _, fake := newTestService(t)
+
+	results := ps.BatchCreateApplications(context.Background(), batchItems(nil, []string{"zone-z"}, nil), BatchOptions{})
+	expectStatuses(t, results, BatchItemSucceeded, BatchItemFailed, BatchItemSucceeded)
+	if fake.Count() != 2 {
+		t.Fatalf("expected 2 deployments, got %d", fake.Count())
+	}
+}
+
+func TestBatchCreateAtomicPolicyFailure(t *testing.T) {
+	ps, _, fake := newTestService(t)
+
+	results := ps.BatchCreateApplications(context.Background(), batchItems(nil, []string{"zone-z"}, nil), BatchOptions{Atomic: true})
+	expectStatuses(t, results, BatchItemSkipped, BatchItemFailed, BatchItemSkipped)
+	if fake.Count() != 0 {
+		t.Fatalf("expected no deployment, got %d", fake.Count())
+	}
+}
+
+func TestBatchCreateAtomicRollback(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	existing := ps.BatchCreateApplications(ctx, batchItems(nil), BatchOptions{})
+	expectStatuses(t, existing, BatchItemSucceeded)
+
+	// The second item passes the policy but cannot be stored, the first one is rolled back
+	items := batchItems(nil, nil)
+	items[1].ID = existing[0].ID.String()
+	results := ps.BatchCreateApplications(ctx, items, BatchOptions{Atomic: true, Concurrency: 1})
+	expectStatuses(t, results, BatchItemRolledBack, BatchItemFailed)
+
+	apps, _, err := s.Application().List(ctx, store.ApplicationFilter{}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(apps) != 1 || fake.Count() != 1 {
+		t.Fatalf("expected only the existing application, got %d applications and %d deployments", len(apps), fake.Count())
+	}
+}
+
+func TestBatchDelete(t *testing.T) {
+	ctx := context.Background()
+	ps, _, fake := newTestService(t)
+
+	created := ps.BatchCreateApplications(ctx, batchItems(nil, nil), BatchOptions{})
+	ids := []uuid.UUID{created[0].ID, uuid.New(), created[1].ID}
+
+	// A missing application aborts an atomic batch
+	results := ps.BatchDeleteApplications(ctx, ids, BatchOptions{Atomic: true})
+	expectStatuses(t, results, BatchItemSkipped, BatchItemFailed, BatchItemSkipped)
+	if fake.Count() != 2 {
+		t.Fatalf("expected no deletion, got %d deployments left", fake.Count())
+	}
+
+	results = ps.BatchDeleteApplications(ctx, ids, BatchOptions{})
+	expectStatuses(t, results, BatchItemSucceeded, BatchItemFailed, BatchItemSucceeded)
+	if fake.Count() != 0 {
+		t.Fatalf("expected deployments to be deleted, %d left", fake.Count())
+	}
+}
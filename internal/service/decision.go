@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// decisionRecord describes the decision of the policy of tier for input, to be saved once
+// the application is stored.
+func decisionRecord(tier *model.Tier, input opa.TierInput, decision *opa.Decision) *model.PlacementDecision {
+	record := &model.PlacementDecision{
+		Tier:        tier.ID,
+		Policy:      tier.PolicyPath,
+		DecisionID:  decision.ID,
+		Revision:    decision.Revision,
+		Result:      decision.Result,
+		Explanation: decision.Explanation,
+	}
+	// The input is kept in the form the policy received it
+	if data, err := json.Marshal(input); err == nil {
+		_ = json.Unmarshal(data, &record.Input)
+	}
+	return record
+}
+
+// saveDecision keeps the decision that placed the application for audits.
+func (s *PlacementService) saveDecision(ctx context.Context, appID uuid.UUID, decision *model.PlacementDecision) {
+	if decision == nil {
+		return
+	}
+	record := *decision
+	record.ApplicationID = appID
+	if err := s.store.Decision().Save(context.WithoutCancel(ctx), record); err != nil {
+		zap.S().Named("placement_service:decisions").Warnw("Failed to save the placement decision", "application", appID, "error", err)
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func TestPlacementDecisionSaved(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetRevision("bundle=42")
+	fake.SetNamespaces([]provider.Namespace{{Name: "zone-a", Labels: map[string]string{"tier": "1"}}})
+
+	tier := 1
+	preview, err := ps.PreviewPlacement(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier})
+	if err != nil || !preview.Allowed {
+		t.Fatalf("PreviewPlacement: %v %v", preview, err)
+	}
+
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	decision, err := s.Decision().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if decision.Tier != 1 || decision.Policy != "tier1" || decision.DecisionID == "" || decision.Revision != "bundle=42" {
+		t.Fatalf("unexpected decision provenance: %+v", decision)
+	}
+	if decision.Input["name"] != "web" || decision.Result["valid"] != true {
+		t.Fatalf("expected the input and result to be saved, got %v and %v", decision.Input, decision.Result)
+	}
+	if zones, _ := decision.Input["available_zones"].([]interface{}); len(zones) != 1 {
+		t.Fatalf("expected the zones of the provider in the input, got %v", decision.Input["available_zones"])
+	}
+
+	if _, err := ps.DeleteApplication(ctx, *app.Id); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+	if _, err := s.Decision().Get(ctx, *app.Id); err != nil {
+		t.Fatalf("expected the decision to be kept after deletion, got %v", err)
+	}
+
+	// Rejected applications have no decision
+	zones := []string{"zone-c"}
+	rejectedID := "123e4567-e89b-12d3-a456-426614174000"
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier, Zones: &zones}, rejectedID, CreateOptions{}); err == nil {
+		t.Fatal("expected the application to be rejected")
+	}
+	if _, err := s.Decision().Get(ctx, uuid.MustParse(rejectedID)); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("expected no decision for a rejected application, got %v", err)
+	}
+}
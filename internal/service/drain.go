@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DrainInProgressError is returned when a zone is drained while its previous drain runs.
+type DrainInProgressError struct {
+	Zone string
+}
+
+func (e *DrainInProgressError) Error() string {
+	return fmt.Sprintf("zone %s is already being drained", e.Zone)
+}
+
+// CordonZone sets whether the zone stops receiving new applications. The applications
+// already placed in the zone are kept.
+func (s *PlacementService) CordonZone(ctx context.Context, id string, cordoned bool) (*model.Zone, error) {
+	zone, err := s.store.Zone().SetCordoned(ctx, id, cordoned)
+	if err != nil {
+		return nil, err
+	}
+	zap.S().Named("placement_service:zones").Infow("Zone cordon changed", "zone", id, "cordoned", cordoned)
+	return zone, nil
+}
+
+// DrainZone cordons the zone and evacuates its applications in the background: the policy of
+// every application placed in the zone is evaluated again without it, replacement deployments
+// are created in the new zones, then the deployments of the zones left are deleted. The
+// returned drain is updated as the applications are evacuated.
+func (s *PlacementService) DrainZone(ctx context.Context, id string) (*model.Drain, error) {
+	if _, running := s.draining.LoadOrStore(id, true); running {
+		return nil, &DrainInProgressError{Zone: id}
+	}
+	drain, apps, err := s.startDrain(ctx, id)
+	if err != nil || drain.Status != model.DrainStatusRunning {
+		s.draining.Delete(id)
+		return drain, err
+	}
+	go func() {
+		defer s.draining.Delete(id)
+		s.evacuate(context.WithoutCancel(ctx), *drain, apps)
+	}()
+	return drain, nil
+}
+
+// startDrain cordons the zone and records the drain of the applications placed in it.
+func (s *PlacementService) startDrain(ctx context.Context, id string) (*model.Drain, []uuid.UUID, error) {
+	if _, err := s.CordonZone(ctx, id, true); err != nil {
+		return nil, nil, err
+	}
+	drain := model.Drain{ID: uuid.New(), ZoneID: id, Status: model.DrainStatusRunning, Applications: []model.DrainItem{}}
+	var apps []uuid.UUID
+	err := s.eachApplication(ctx, func(app model.Application) {
+		if slices.Contains(app.Zones, id) {
+			apps = append(apps, app.ID)
+			drain.Applications = append(drain.Applications, model.DrainItem{ApplicationID: app.ID, Name: app.Name, Status: model.DrainItemPending})
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(apps) == 0 {
+		now := time.Now()
+		drain.Status = model.DrainStatusCompleted
+		drain.CompletedAt = &now
+	}
+	created, err := s.store.Drain().Create(ctx, drain)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, apps, nil
+}
+
+// evacuate moves the applications out of the drained zone one at a time, storing the
+// progress of the drain after each of them.
+func (s *PlacementService) evacuate(ctx context.Context, drain model.Drain, apps []uuid.UUID) {
+	logger := zap.S().Named("placement_service:drain")
+	for i, id := range apps {
+		item := &drain.Applications[i]
+		zones, err := s.evacuateApplication(ctx, id, drain.ZoneID)
+		if err != nil {
+			logger.Warnw("Failed to evacuate application", "zone", drain.ZoneID, "application", id, "error", err)
+			item.Status, item.Error = model.DrainItemFailed, err.Error()
+			drain.Failed++
+		} else {
+			item.Status, item.Zones = model.DrainItemEvacuated, zones
+			drain.Evacuated++
+		}
+		if i == len(apps)-1 {
+			now := time.Now()
+			drain.CompletedAt = &now
+			drain.Status = model.DrainStatusCompleted
+			if drain.Failed > 0 {
+				drain.Status = model.DrainStatusFailed
+			}
+		}
+		if _, err := s.store.Drain().Update(ctx, drain); err != nil {
+			logger.Warnw("Failed to store the drain progress", "zone", drain.ZoneID, "error", err)
+		}
+	}
+	logger.Infow("Zone drained", "zone", drain.ZoneID, "evacuated", drain.Evacuated, "failed", drain.Failed)
+}
+
+// evacuateApplication places the application again without zone and returns its new zones.
+// Zones requested by the user are dropped when they include the drained zone, letting the
+// policy choose.
+func (s *PlacementService) evacuateApplication(ctx context.Context, id uuid.UUID, zone string) ([]string, error) {
+	defer s.applications.lock(id)()
+	app, err := s.store.Application().Get(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Deleted since the drain started
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(app.Zones, zone) {
+		return app.Zones, nil
+	}
+	tier, err := s.store.Tier().Get(ctx, app.Tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier %d: %w", app.Tier, err)
+	}
+	request := applicationRequest(app)
+	if request.Zones != nil && slices.Contains(*request.Zones, zone) {
+		request.Zones = nil
+	}
+	decision, selections, err := s.evaluate(ctx, request, tier, evaluation{current: app})
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.relocate(ctx, app, selections)
+	if err != nil {
+		return nil, err
+	}
+
+	s.saveDecision(ctx, app.ID, decision)
+	s.recordEvent(ctx, app.ID, EventApplicationEvacuated, fmt.Sprintf("evacuated from zone %s to zones %v", zone, updated.Zones))
+	s.publish(WatchApplicationUpdated, updated, nil)
+	return []string(updated.Zones), nil
+}
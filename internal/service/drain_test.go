@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+)
+
+func TestDrainZone(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	fake.SetNamespaces([]provider.Namespace{{Name: "zone-a"}, {Name: "zone-b"}, {Name: "zone-c"}})
+	if err := ps.SyncZones(ctx); err != nil {
+		t.Fatalf("SyncZones: %v", err)
+	}
+	ps.opa.(*opa.FakeEngine).SetCandidates(3, []opa.ZoneCandidate{
+		{Zone: "zone-a", Region: "east", Score: 3},
+		{Zone: "zone-b", Region: "east", Score: 2},
+		{Zone: "zone-c", Region: "west", Score: 1},
+	}, 2)
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 3, PolicyPath: opa.TierPolicyPath(3)}); err != nil {
+		t.Fatalf("CreateTier: %v", err)
+	}
+
+	tier := 3
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Webserver, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	placed, err := s.Application().Get(ctx, *app.Id)
+	if err != nil || !slices.Contains(placed.Zones, "zone-a") {
+		t.Fatalf("expected the application in zone-a, got %+v %v", placed, err)
+	}
+
+	// A second drain is refused while the first one runs
+	ps.draining.Store("zone-a", true)
+	var inProgress *DrainInProgressError
+	if _, err := ps.DrainZone(ctx, "zone-a"); !errors.As(err, &inProgress) {
+		t.Fatalf("expected the drain to be in progress, got %v", err)
+	}
+	ps.draining.Delete("zone-a")
+
+	drain, err := ps.DrainZone(ctx, "zone-a")
+	if err != nil {
+		t.Fatalf("DrainZone: %v", err)
+	}
+	if drain.Status != model.DrainStatusRunning || len(drain.Applications) != 1 {
+		t.Fatalf("expected a running drain of 1 application, got %+v", drain)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for drain.Status == model.DrainStatusRunning {
+		if time.Now().After(deadline) {
+			t.Fatal("drain did not complete")
+		}
+		time.Sleep(10 * time.Millisecond)
+		if drain, err = s.Drain().Latest(ctx, "zone-a"); err != nil {
+			t.Fatalf("Latest: %v", err)
+		}
+	}
+	if drain.Status != model.DrainStatusCompleted || drain.Evacuated != 1 || drain.CompletedAt == nil {
+		t.Fatalf("expected the drain to complete, got %+v", drain)
+	}
+
+	moved, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	zones := slices.Clone([]string(moved.Zones))
+	slices.Sort(zones)
+	if !slices.Equal(zones, []string{"zone-b", "zone-c"}) || !slices.Equal(drain.Applications[0].Zones, moved.Zones) {
+		t.Fatalf("expected the application moved to zone-b and zone-c, got %v", moved.Zones)
+	}
+	if fake.Count() != 2 || len(moved.DeploymentIDs) != 2 {
+		t.Fatalf("expected the deployment of zone-a to be replaced, got %d deployments", fake.Count())
+	}
+	events, _, err := s.Event().List(ctx, store.EventFilter{ApplicationID: app.Id}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !slices.ContainsFunc(events, func(e model.Event) bool { return e.Type == EventApplicationEvacuated }) {
+		t.Fatalf("expected an evacuation event, got %+v", events)
+	}
+
+	// The cordoned zone receives no new application until it is uncordoned
+	if zone, err := s.Zone().Get(ctx, "zone-a"); err != nil || !zone.Cordoned {
+		t.Fatalf("expected zone-a to be cordoned, got %+v %v", zone, err)
+	}
+	other, err := ps.CreateApplication(ctx, &server.Application{Name: "api", Service: server.Webserver, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	for _, selection := range *other.Placement {
+		if selection.Zone == "zone-a" {
+			t.Fatalf("expected the cordoned zone-a to be avoided, got %+v", *other.Placement)
+		}
+	}
+	if _, err := ps.CordonZone(ctx, "zone-a", false); err != nil {
+		t.Fatalf("CordonZone: %v", err)
+	}
+	if _, err := ps.CordonZone(ctx, "zone-x", true); err == nil {
+		t.Fatal("expected an unknown zone to be reported")
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Event types recorded in the audit log.
+const (
+	EventApplicationCreated    = "application.created"
+	EventApplicationDeployed   = "application.deployed"
+	EventApplicationReady      = "application.ready"
+	EventApplicationFailed     = "application.failed"
+	EventApplicationRolledBack = "application.rolled_back"
+	EventApplicationDeleted    = "application.deleted"
+	EventApplicationEvacuated  = "application.evacuated"
+	EventApplicationFailedOver = "application.failed_over"
+	EventApplicationScaled     = "application.scaled"
+	EventApplicationReplaced   = "application.replaced"
+	EventPolicyAllowed         = "policy.allowed"
+	EventPolicyRejected        = "policy.rejected"
+	EventPolicyError           = "policy.error"
+	EventQuotaExceeded         = "quota.exceeded"
+	EventDeploymentCreated     = "provider.deployment_created"
+	EventDeploymentFailed      = "provider.deployment_failed"
+	EventDeploymentDeleted     = "provider.deployment_deleted"
+	EventDeploymentDeleteError = "provider.deployment_delete_failed"
+)
+
+// NotificationTypes are the lifecycle events delivered to notifiers.
+var NotificationTypes = []string{
+	EventApplicationCreated,
+	EventApplicationDeployed,
+	EventApplicationFailed,
+	EventApplicationDeleted,
+}
+
+// Notifier is told about the lifecycle changes of applications.
+type Notifier interface {
+	Notify(ctx context.Context, eventType string, app server.ApplicationResponse)
+}
+
+// AnonymousActor is recorded when the request does not identify its caller.
+const AnonymousActor = "anonymous"
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the user or system on whose behalf the service acts.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or AnonymousActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
+
+// eventOption sets the optional fields of an event.
+type eventOption func(*model.Event)
+
+func withDeployment(d deploymentRef) eventOption {
+	return func(e *model.Event) {
+		e.Zone = d.zone
+		e.Provider = d.provider
+		e.DeploymentID = d.id
+	}
+}
+
+func withZone(zone, providerName string) eventOption {
+	return func(e *model.Event) {
+		e.Zone = zone
+		e.Provider = providerName
+	}
+}
+
+// notify passes a lifecycle change of app to every notifier.
+func (s *PlacementService) notify(ctx context.Context, eventType string, app *model.Application) {
+	response := mappers.ApplicationToAPI(*app)
+	for _, n := range s.notifiers {
+		n.Notify(ctx, eventType, *response)
+	}
+}
+
+// publish streams a change of app to the watchers.
+func (s *PlacementService) publish(watchType string, app *model.Application, deployments []server.ZoneDeployment) {
+	response := mappers.ApplicationToAPI(*app)
+	response.Deployments = optionalSlice(deployments)
+	s.watchers.publish(watchType, *response)
+}
+
+// recordEvent appends an event to the audit log. Failing to record an event is logged
+// but never fails the operation being audited.
+func (s *PlacementService) recordEvent(ctx context.Context, appID uuid.UUID, eventType, message string, opts ...eventOption) {
+	event := model.Event{
+		ApplicationID: appID,
+		Type:          eventType,
+		Message:       message,
+		Actor:         ActorFromContext(ctx),
+		RequestID:     middleware.GetReqID(ctx),
+	}
+	for _, opt := range opts {
+		opt(&event)
+	}
+	// The audit trail must survive a cancelled request
+	if _, err := s.store.Event().Create(context.WithoutCancel(ctx), event); err != nil {
+		zap.S().Named("placement_service:events").Warnw("Failed to record event", "type", eventType, "application", appID, "error", err)
+	}
+}
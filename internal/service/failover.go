@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidFailoverTarget is returned when the target of a failover is not an environment.
+var ErrInvalidFailoverTarget = errors.New("invalid failover target")
+
+// FailoverConflictError is returned when an application cannot move to the target environment.
+type FailoverConflictError struct {
+	Reason string
+	// noTarget is set when the tier has no other zones to move the application to
+	noTarget bool
+}
+
+func (e *FailoverConflictError) Error() string {
+	return "cannot fail over: " + e.Reason
+}
+
+// FailoverOptions controls where Failover moves an application.
+type FailoverOptions struct {
+	// Target is the environment the application moves to, the one it is not in when empty
+	Target string
+	// Reason is kept in the failover history of the application
+	Reason string
+	// Automatic is set when every production deployment of the application failed
+	Automatic bool
+}
+
+// Failover moves the deployments of an application between the production and backup zones
+// of its tier. The policy is evaluated again for the target environment, ignoring the zones
+// requested by the user, then the deployments are moved as for evacuations.
+func (s *PlacementService) Failover(ctx context.Context, id uuid.UUID, opts FailoverOptions) (*server.ApplicationResponse, error) {
+	if _, running := s.failingOver.LoadOrStore(id, true); running {
+		return nil, &FailoverConflictError{Reason: fmt.Sprintf("application %s is already failing over", id)}
+	}
+	defer s.failingOver.Delete(id)
+	defer s.applications.lock(id)()
+
+	app, err := s.store.Application().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := app.InEnvironment()
+	target := opts.Target
+	if target == "" {
+		target = model.EnvironmentBackup
+		if from == model.EnvironmentBackup {
+			target = model.EnvironmentProduction
+		}
+	}
+	if target != model.EnvironmentProduction && target != model.EnvironmentBackup {
+		return nil, fmt.Errorf("%w %q, expected %s or %s", ErrInvalidFailoverTarget, target, model.EnvironmentProduction, model.EnvironmentBackup)
+	}
+	if target == from {
+		return nil, &FailoverConflictError{Reason: fmt.Sprintf("application %s is already in the %s zones", id, target)}
+	}
+
+	tier, err := s.store.Tier().Get(ctx, app.Tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier %d: %w", app.Tier, err)
+	}
+	request := applicationRequest(app)
+	request.Zones = nil
+	decision, selections, err := s.evaluate(ctx, request, tier, evaluation{current: app, environment: target})
+	if err != nil {
+		return nil, err
+	}
+	zones := selectedZones(selections)
+	if sameZones(zones, app.Zones) {
+		return nil, &FailoverConflictError{Reason: fmt.Sprintf("tier %d has no %s zones apart from %v", app.Tier, target, zones), noTarget: true}
+	}
+
+	failover := model.Failover{
+		From:      from,
+		To:        target,
+		FromZones: []string(app.Zones),
+		ToZones:   zones,
+		Reason:    opts.Reason,
+		Automatic: opts.Automatic,
+		At:        time.Now(),
+	}
+	app.Environment = target
+	app.Failovers = append(app.Failovers, failover)
+	app.Status, app.StatusMessage = model.ApplicationStatusDeploying, ""
+	updated, err := s.relocate(ctx, app, selections)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("failed over from %s zones %v to %s zones %v", from, failover.FromZones, target, updated.Zones)
+	if opts.Reason != "" {
+		message += ": " + opts.Reason
+	}
+	zap.S().Named("placement_service:failover").Infow("Application failed over", "application", id, "from", from, "to", target, "automatic", opts.Automatic)
+	s.saveDecision(ctx, app.ID, decision)
+	s.recordEvent(ctx, app.ID, EventApplicationFailedOver, message)
+	s.notify(ctx, EventApplicationFailedOver, updated)
+	s.publish(WatchApplicationUpdated, updated, nil)
+	return mappers.ApplicationToAPI(*updated), nil
+}
+
+// ReconcileFailovers fails over to the backup zones the applications placed in production
+// whose deployments all report the failed phase. Applications the policy finds no backup zones
+// for are skipped until their tier or zones change, instead of being evaluated every interval.
+func (s *PlacementService) ReconcileFailovers(ctx context.Context) error {
+	var production []model.Application
+	err := s.eachApplication(ctx, func(app model.Application) {
+		if app.InEnvironment() == model.EnvironmentProduction && len(app.DeploymentIDs) > 0 {
+			production = append(production, app)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.forgetNoFailoverTargets(production)
+
+	var errs []error
+	for _, app := range production {
+		key := failoverTargetKey(app)
+		if skipped, ok := s.noFailoverTarget.Load(app.ID); ok && skipped == key {
+			continue
+		}
+		if !s.allDeploymentsFailed(ctx, &app) {
+			continue
+		}
+		_, err := s.Failover(ctx, app.ID, FailoverOptions{
+			Target:    model.EnvironmentBackup,
+			Reason:    "every production deployment failed",
+			Automatic: true,
+		})
+		if hasNoFailoverTarget(err) {
+			zap.S().Named("placement_service:failover").Warnw("No backup zones to fail the application over to",
+				"application", app.ID, "tier", app.Tier, "error", err)
+			s.noFailoverTarget.Store(app.ID, key)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("application %s: %w", app.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// failoverTargetKey identifies the placement the backup zones of app were looked up for.
+func failoverTargetKey(app model.Application) string {
+	return fmt.Sprintf("%d/%v", app.Tier, []string(app.Zones))
+}
+
+// hasNoFailoverTarget reports whether err tells the policy has no backup zones to offer.
+func hasNoFailoverTarget(err error) bool {
+	var rejected *PolicyRejectedError
+	var conflict *FailoverConflictError
+	return errors.As(err, &rejected) || errors.As(err, &conflict) && conflict.noTarget
+}
+
+// forgetNoFailoverTargets drops the applications no longer in production from the ones
+// skipped by ReconcileFailovers.
+func (s *PlacementService) forgetNoFailoverTargets(production []model.Application) {
+	ids := make(map[uuid.UUID]bool, len(production))
+	for _, app := range production {
+		ids[app.ID] = true
+	}
+	s.noFailoverTarget.Range(func(id, _ any) bool {
+		if !ids[id.(uuid.UUID)] {
+			s.noFailoverTarget.Delete(id)
+		}
+		return true
+	})
+}
+
+// allDeploymentsFailed reports whether every deployment of app is in the failed phase.
+// Deployments whose status cannot be fetched are not considered failed.
+func (s *PlacementService) allDeploymentsFailed(ctx context.Context, app *model.Application) bool {
+	for _, d := range applicationDeployments(app) {
+		p, err := s.providers.Get(d.provider)
+		if err != nil {
+			return false
+		}
+		deployment, err := p.GetDeployment(ctx, d.id)
+		if err != nil || deployment.Status == nil || deployment.Status.Phase == nil ||
+			*deployment.Status.Phase != provider.DeploymentStatusPhaseFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// RunFailoverReconciler checks the production deployments every interval until ctx is done.
+func (s *PlacementService) RunFailoverReconciler(ctx context.Context, interval time.Duration) {
+	logger := zap.S().Named("placement_service:failover")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.ReconcileFailovers(ctx); err != nil {
+			logger.Warnw("Failed to fail applications over", "error", err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+)
+
+func TestFailover(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	failedOver, err := ps.Failover(ctx, *app.Id, FailoverOptions{Reason: "maintenance"})
+	if err != nil {
+		t.Fatalf("Failover: %v", err)
+	}
+	if *failedOver.Environment != server.ApplicationResponseEnvironmentBackup || !slices.Equal(*failedOver.Zones, []string{"zone-d"}) {
+		t.Fatalf("expected the application in the backup zone-d, got %v %v", *failedOver.Environment, *failedOver.Zones)
+	}
+	if fake.Count() != 1 {
+		t.Fatalf("expected the production deployments to be replaced, got %d deployments", fake.Count())
+	}
+	history := *failedOver.Failovers
+	if len(history) != 1 || history[0].From != model.EnvironmentProduction || history[0].To != model.EnvironmentBackup ||
+		!slices.Equal(*history[0].FromZones, []string{"zone-a", "zone-b"}) || history[0].Automatic || *history[0].Reason != "maintenance" {
+		t.Fatalf("unexpected failover history %+v", history)
+	}
+
+	var conflict *FailoverConflictError
+	if _, err := ps.Failover(ctx, *app.Id, FailoverOptions{Target: model.EnvironmentBackup}); !errors.As(err, &conflict) {
+		t.Fatalf("expected a failover to the current environment to conflict, got %v", err)
+	}
+
+	// Without a target the application fails back to production
+	failedBack, err := ps.Failover(ctx, *app.Id, FailoverOptions{})
+	if err != nil {
+		t.Fatalf("Failover: %v", err)
+	}
+	if *failedBack.Environment != server.ApplicationResponseEnvironmentProduction || len(*failedBack.Failovers) != 2 || fake.Count() != 2 {
+		t.Fatalf("expected the application back in production, got %+v", failedBack)
+	}
+	events, _, err := s.Event().List(ctx, store.EventFilter{ApplicationID: app.Id, Type: EventApplicationFailedOver}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 failover events, got %d", len(events))
+	}
+}
+
+func TestReconcileFailovers(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	// A single failed deployment is not a reason to fail over
+	if err := fake.SetPhase(stored.DeploymentIDs[0], provider.DeploymentStatusPhaseFailed); err != nil {
+		t.Fatalf("SetPhase: %v", err)
+	}
+	if err := ps.ReconcileFailovers(ctx); err != nil {
+		t.Fatalf("ReconcileFailovers: %v", err)
+	}
+	if stored, err = s.Application().Get(ctx, *app.Id); err != nil || stored.InEnvironment() != model.EnvironmentProduction {
+		t.Fatalf("expected the application to stay in production, got %+v %v", stored, err)
+	}
+
+	if err := fake.SetPhase(stored.DeploymentIDs[1], provider.DeploymentStatusPhaseFailed); err != nil {
+		t.Fatalf("SetPhase: %v", err)
+	}
+	if err := ps.ReconcileFailovers(ctx); err != nil {
+		t.Fatalf("ReconcileFailovers: %v", err)
+	}
+	stored, err = s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if stored.Environment != model.EnvironmentBackup || len(stored.Failovers) != 1 || !stored.Failovers[0].Automatic {
+		t.Fatalf("expected an automatic failover to backup, got %+v", stored)
+	}
+	if !slices.Equal([]string(stored.Zones), []string{"zone-d"}) || stored.Status != model.ApplicationStatusDeploying {
+		t.Fatalf("expected the application deploying in zone-d, got %v %s", stored.Zones, stored.Status)
+	}
+}
+
+func TestReconcileFailoversWithoutBackupZones(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	for _, id := range stored.DeploymentIDs {
+		if err := fake.SetPhase(id, provider.DeploymentStatusPhaseFailed); err != nil {
+			t.Fatalf("SetPhase: %v", err)
+		}
+	}
+	engine := &countingEngine{Engine: ps.opa}
+	ps.opa = engine
+
+	// Tier 1 has no backup zones: the application is only evaluated once
+	for range 3 {
+		if err := ps.ReconcileFailovers(ctx); err != nil {
+			t.Fatalf("ReconcileFailovers: %v", err)
+		}
+	}
+	if engine.evaluations != 1 {
+		t.Fatalf("expected a single failover attempt, got %d evaluations", engine.evaluations)
+	}
+
+	// Once its zones change the application is evaluated again
+	engine.Engine.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+	stored.Zones = []string{"zone-a"}
+	if _, err := s.Application().Update(ctx, *stored); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err := ps.ReconcileFailovers(ctx); err != nil {
+		t.Fatalf("ReconcileFailovers: %v", err)
+	}
+	if stored, err = s.Application().Get(ctx, *app.Id); err != nil || stored.InEnvironment() != model.EnvironmentBackup {
+		t.Fatalf("expected the application failed over to backup, got %+v %v", stored, err)
+	}
+}
+
+func TestFailoverScaledApplication(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 3}); err != nil {
+		t.Fatalf("ScaleApplication: %v", err)
+	}
+
+	if _, err := ps.Failover(ctx, *app.Id, FailoverOptions{}); err != nil {
+		t.Fatalf("Failover: %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil || len(stored.DeploymentIDs) != 1 {
+		t.Fatalf("expected a single backup deployment, got %+v %v", stored, err)
+	}
+	deployment, err := fake.GetDeployment(ctx, stored.DeploymentIDs[0])
+	if err != nil {
+		t.Fatalf("GetDeployment: %v", err)
+	}
+	container, err := deployment.Spec.AsContainerSpec()
+	if err != nil || *container.Container.Replicas != 3 {
+		t.Fatalf("expected the backup deployment to run the 3 replicas, got %+v %v", container, err)
+	}
+}
+
+func TestFailoverWithoutProviderForKind(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	// The backup zone is served by a provider running virtual machines only
+	if err := ps.providers.Register("vms", provider.NewFakeProvider(provider.FakeOptions{}), []string{"vm"}, []string{"zone-d"}, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if _, err := ps.Failover(ctx, *app.Id, FailoverOptions{}); err == nil || !strings.Contains(err.Error(), `does not support kind "container"`) {
+		t.Fatalf("expected the failover to fail for lack of a provider, got %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !slices.Equal(stored.Zones, []string{"zone-a", "zone-b"}) || fake.Count() != 2 {
+		t.Fatalf("expected the application to stay in its zones, got %v with %d deployments", stored.Zones, fake.Count())
+	}
+}
+
+// countingEngine counts the tier policy evaluations.
+type countingEngine struct {
+	opa.Engine
+	evaluations int
+}
+
+func (e *countingEngine) EvalTierPolicy(ctx context.Context, policy string, input opa.TierInput) (*opa.Decision, error) {
+	e.evaluations++
+	return e.Engine.EvalTierPolicy(ctx, policy, input)
+}
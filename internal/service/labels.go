@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+)
+
+var (
+	// labelName is the syntax of label names and values, as in Kubernetes
+	labelName = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	// labelPrefix is the optional DNS subdomain prefixing a key, "example.com/" in "example.com/name"
+	labelPrefix = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// InvalidLabelsError is returned when the labels or annotations of an application are invalid.
+type InvalidLabelsError struct {
+	Reasons []string
+}
+
+func (e *InvalidLabelsError) Error() string {
+	return "invalid labels or annotations: " + strings.Join(e.Reasons, ", ")
+}
+
+// validateLabels checks the syntax of the label and annotation keys of the request, the
+// syntax of the label values, and that no reserved key is set.
+func validateLabels(request *server.CreateApplicationJSONRequestBody) error {
+	var reasons []string
+	if request.Labels != nil {
+		for _, key := range sortedKeys(*request.Labels) {
+			if provider.IsReservedLabel(key) {
+				reasons = append(reasons, fmt.Sprintf("label %q is reserved", key))
+			} else if err := validLabelKey(key); err != nil {
+				reasons = append(reasons, fmt.Sprintf("label %q: %v", key, err))
+			} else if value := (*request.Labels)[key]; !validLabelValue(value) {
+				reasons = append(reasons, fmt.Sprintf("label %q: invalid value %q", key, value))
+			}
+		}
+	}
+	if request.Annotations != nil {
+		for _, key := range sortedKeys(*request.Annotations) {
+			if strings.HasPrefix(key, provider.ReservedLabelPrefix) {
+				reasons = append(reasons, fmt.Sprintf("annotation %q is reserved", key))
+			} else if err := validLabelKey(key); err != nil {
+				reasons = append(reasons, fmt.Sprintf("annotation %q: %v", key, err))
+			}
+		}
+	}
+	if len(reasons) > 0 {
+		return &InvalidLabelsError{Reasons: reasons}
+	}
+	return nil
+}
+
+// validLabelKey checks a key made of an optional DNS subdomain prefix and a name.
+func validLabelKey(key string) error {
+	name := key
+	if prefix, rest, found := strings.Cut(key, "/"); found {
+		if len(prefix) > 253 || !labelPrefix.MatchString(prefix) {
+			return fmt.Errorf("invalid prefix %q", prefix)
+		}
+		name = rest
+	}
+	if len(name) > 63 || !labelName.MatchString(name) {
+		return fmt.Errorf("invalid name %q", name)
+	}
+	return nil
+}
+
+// validLabelValue checks a label value, which may be empty.
+func validLabelValue(value string) bool {
+	return value == "" || (len(value) <= 63 && labelName.MatchString(value))
+}
+
+// ParseLabelSelector parses a comma separated list of key=value and key requirements. The
+// value of the keys only required to exist is nil.
+func ParseLabelSelector(selector string) (map[string]*string, error) {
+	result := map[string]*string{}
+	if strings.TrimSpace(selector) == "" {
+		return result, nil
+	}
+	for _, requirement := range strings.Split(selector, ",") {
+		key, value, hasValue := strings.Cut(strings.TrimSpace(requirement), "=")
+		key = strings.TrimSpace(key)
+		if err := validLabelKey(key); err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", requirement, err)
+		}
+		if !hasValue {
+			result[key] = nil
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if !validLabelValue(value) {
+			return nil, fmt.Errorf("invalid label selector %q: invalid value %q", requirement, value)
+		}
+		result[key] = &value
+	}
+	return result, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+)
+
+func TestCreateApplicationLabels(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	labels := map[string]string{"app": "shop", "example.com/team": "payments"}
+	annotations := map[string]string{"owner": "team-a@example.com"}
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "shop", Service: server.Container, Labels: &labels, Annotations: &annotations}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if stored.Annotations["owner"] != "team-a@example.com" {
+		t.Fatalf("expected annotations to be stored, got %v", stored.Annotations)
+	}
+
+	if len(stored.DeploymentIDs) == 0 {
+		t.Fatal("expected deployments")
+	}
+	for _, id := range stored.DeploymentIDs {
+		deployment, err := fake.GetDeployment(ctx, id)
+		if err != nil {
+			t.Fatalf("GetDeployment: %v", err)
+		}
+		got := *deployment.Metadata.Labels
+		if got[provider.AppIDLabel] != app.Id.String() || got["app"] != "shop" || got["example.com/team"] != "payments" {
+			t.Fatalf("expected user labels and the application ID on the deployment, got %v", got)
+		}
+	}
+}
+
+func TestCreateApplicationInvalidLabels(t *testing.T) {
+	ctx := context.Background()
+	ps, _, fake := newTestService(t)
+
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+	}{
+		{name: "application ID", labels: map[string]string{provider.AppIDLabel: "x"}},
+		{name: "reserved prefix", labels: map[string]string{"dcm.io/zone": "a"}},
+		{name: "invalid key", labels: map[string]string{"bad key": "a"}},
+		{name: "invalid value", labels: map[string]string{"app": "not valid!"}},
+		{name: "reserved annotation", annotations: map[string]string{"dcm.io/owner": "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &server.Application{Name: "web", Service: server.Container}
+			if tt.labels != nil {
+				request.Labels = &tt.labels
+			}
+			if tt.annotations != nil {
+				request.Annotations = &tt.annotations
+			}
+			_, err := ps.CreateApplication(ctx, request, "", CreateOptions{})
+			var invalid *InvalidLabelsError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("expected InvalidLabelsError, got %v", err)
+			}
+			if _, err := ps.PreviewPlacement(ctx, request); !errors.As(err, &invalid) {
+				t.Fatalf("expected the preview to fail with InvalidLabelsError, got %v", err)
+			}
+		})
+	}
+	if fake.Count() != 0 {
+		t.Fatalf("expected no deployment, got %d", fake.Count())
+	}
+}
+
+func TestListApplicationsLabelSelector(t *testing.T) {
+	ctx := context.Background()
+	ps, s, _ := newTestService(t)
+
+	for _, labels := range []map[string]string{
+		{"app": "shop", "role": "web"},
+		{"app": "shop", "role": "cache"},
+		{"app": "blog"},
+	} {
+		if _, err := ps.CreateApplication(ctx, &server.Application{Name: labels["app"], Service: server.Container, Labels: &labels}, "", CreateOptions{}); err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+	}
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "unlabeled", Service: server.Container}, "", CreateOptions{}); err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     int
+	}{
+		{selector: "", want: 4},
+		{selector: "app=shop", want: 2},
+		{selector: "app=shop,role=cache", want: 1},
+		{selector: "role", want: 2},
+		{selector: "app=shop, role", want: 2},
+		{selector: "app=none", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			labels, err := ParseLabelSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector: %v", err)
+			}
+			apps, _, err := s.Application().List(ctx, store.ApplicationFilter{Labels: labels}, nil, nil)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if len(apps) != tt.want {
+				t.Fatalf("expected %d applications, got %d", tt.want, len(apps))
+			}
+		})
+	}
+
+	if _, err := ParseLabelSelector("app=shop,bad key"); err == nil {
+		t.Fatal("expected an invalid selector to fail")
+	}
+}
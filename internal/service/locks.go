@@ -0,0 +1,47 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// applicationLocks serializes the changes made to each application. Drains, failovers,
+// scaling, replacements and deletions read an application, change its deployments and store
+// it back, and would otherwise overwrite each other.
+type applicationLocks struct {
+	mu    sync.Mutex
+	locks map[uuid.UUID]*applicationLock
+}
+
+type applicationLock struct {
+	sync.Mutex
+	// holders counts the callers holding or waiting for the lock
+	holders int
+}
+
+// lock waits until no other change of the application id is in progress, and returns the
+// function ending the change.
+func (l *applicationLocks) lock(id uuid.UUID) (unlock func()) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[uuid.UUID]*applicationLock{}
+	}
+	lock, ok := l.locks[id]
+	if !ok {
+		lock = &applicationLock{}
+		l.locks[id] = lock
+	}
+	lock.holders++
+	l.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if lock.holders--; lock.holders == 0 {
+			delete(l.locks, id)
+		}
+	}
+}
@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/capacity"
 	"github.com/dcm-project/dcm-placement-api/internal/catalog"
 	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
 	"github.com/dcm-project/dcm-placement-api/internal/opa"
@@ -16,136 +22,533 @@ import (
 )
 
 type PlacementService struct {
-	store           store.Store
-	opa             *opa.Validator
-	providerService *provider.Service
+	store        store.Store
+	opa          opa.Engine
+	providers    *provider.Registry
+	notifiers    []Notifier
+	watchers     *broadcaster
+	ledger       *capacity.Ledger
+	inventory    *provider.Inventory
+	pollInterval time.Duration
+	// draining holds the zones whose applications are being evacuated
+	draining sync.Map
+	// failingOver holds the applications being moved between environments
+	failingOver sync.Map
+	// noFailoverTarget holds the tier and zones of the applications found without backup zones
+	noFailoverTarget sync.Map
+	// applications serializes the changes made to each application
+	applications applicationLocks
+	// quotaMu makes checking the quotas and storing an application atomic. A single lock is
+	// used as quotas may span tenants and tiers.
+	quotaMu sync.Mutex
+	// simulationLimit and simulationTimeout bound the work of Simulate
+	simulationLimit   int
+	simulationTimeout time.Duration
 }
 
-func NewPlacementService(store store.Store, opa *opa.Validator,
-	providerService *provider.Service) *PlacementService {
-	return &PlacementService{store: store, opa: opa, providerService: providerService}
+// defaultInventoryTTL is how long the zones listed by the providers are reused
+const defaultInventoryTTL = 30 * time.Second
+
+func NewPlacementService(store store.Store, policy opa.Engine,
+	providers *provider.Registry, notifiers ...Notifier) *PlacementService {
+	return &PlacementService{store: store, opa: policy, providers: providers, notifiers: notifiers,
+		watchers: newBroadcaster(), ledger: capacity.NewLedger(store, nil),
+		inventory: provider.NewInventory(providers, defaultInventoryTTL), pollInterval: readyPollInterval,
+		simulationLimit: defaultSimulationLimit, simulationTimeout: defaultSimulationTimeout}
 }
 
-func (s *PlacementService) CreateApplication(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string) (*server.ApplicationResponse, error) {
-	logger := zap.S().Named("placement_service:create_app")
+// SetInventoryTTL sets how long the zones listed by the providers are reused.
+func (s *PlacementService) SetInventoryTTL(ttl time.Duration) {
+	s.inventory = provider.NewInventory(s.providers, ttl)
+}
 
-	// OPA validation:
-	tier := 2
-	if request.Tier != nil {
-		tier = *request.Tier
+// SetSimulationLimits sets the maximum number of applications Simulate evaluates and how long
+// it may take.
+func (s *PlacementService) SetSimulationLimits(limit int, timeout time.Duration) {
+	s.simulationLimit = limit
+	s.simulationTimeout = timeout
+}
+
+// SetCapacities sets the capacity of zones, passed to the policy along with their usage.
+func (s *PlacementService) SetCapacities(capacities map[string]catalog.Resources) {
+	for zone, capacity := range capacities {
+		s.ledger.SetCapacity(zone, capacity)
+	}
+}
+
+// CreateOptions controls how CreateApplication treats the deployments once created.
+type CreateOptions struct {
+	// WaitForReady blocks until every zone deployment is running
+	WaitForReady bool
+	// ReadyTimeout bounds the wait, defaults to defaultReadyTimeout
+	ReadyTimeout time.Duration
+	// RollbackOnFailure deletes the application when it does not become ready,
+	// otherwise it is kept with a failed status
+	RollbackOnFailure bool
+}
+
+// deploymentRef identifies a deployment created on a named provider.
+type deploymentRef struct {
+	zone     string
+	provider string
+	id       string
+}
+
+// placement is the outcome of the policy evaluation of an application.
+type placement struct {
+	id         uuid.UUID
+	tenant     string
+	tier       int
+	zones      []string
+	selections []model.ZoneSelection
+	decision   *model.PlacementDecision
+}
+
+// application returns the application placed, as counted by the quotas.
+func (p *placement) application(request *server.CreateApplicationJSONRequestBody) model.Application {
+	return model.Application{ID: p.id, Service: string(request.Service), Tenant: p.tenant, Tier: p.tier, Zones: p.zones}
+}
+
+func (s *PlacementService) CreateApplication(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string, opts CreateOptions) (*server.ApplicationResponse, error) {
+	p, err := s.place(ctx, request, appID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.deploy(ctx, request, p, opts)
+}
+
+// place evaluates the policy of the application and resolves the provider of every zone
+// it is placed in, without creating anything. current is the stored application the request
+// replaces, if any.
+func (s *PlacementService) place(ctx context.Context, request *server.CreateApplicationJSONRequestBody, appID string, current *model.Application) (*placement, error) {
+	applicationID := uuid.New()
+	if appID != "" {
+		var err error
+		if applicationID, err = uuid.Parse(appID); err != nil {
+			return nil, fmt.Errorf("invalid application ID %q: %w", appID, err)
+		}
+	}
+
+	if err := validateLabels(request); err != nil {
+		return nil, err
 	}
-	logger.Info("Evaluating policy: ", "Tier: ", fmt.Sprintf("%d", tier))
-	result, err := s.opa.EvalTierPolicy(ctx, tier, request.Name, request.Zones)
+	registered, err := s.resolveTier(ctx, request)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateZones(ctx, request); err != nil {
+		return nil, err
+	}
+	tier := registered.ID
+	decision, selections, err := s.evaluate(ctx, request, registered, evaluation{current: current, id: applicationID})
+	zones := selectedZones(selections)
+	var rejected *PolicyRejectedError
+	switch {
+	case errors.As(err, &rejected):
+		s.recordEvent(ctx, applicationID, EventPolicyRejected, err.Error())
+		return nil, err
+	case err != nil:
+		s.recordEvent(ctx, applicationID, EventPolicyError, err.Error())
+		return nil, err
+	}
+	message := fmt.Sprintf("tier %d placed in zones %v", tier, zones)
+	if decision.DecisionID != "" {
+		message += " by decision " + decision.DecisionID
+	}
+	if decision.Revision != "" {
+		message += " at revision " + decision.Revision
+	}
+	s.recordEvent(ctx, applicationID, EventPolicyAllowed, message)
+
+	// Resolve the provider of every zone before creating anything
+	kind := deploymentKind(request.Service)
+	for _, zone := range zones {
+		if _, _, err := s.providerForZone(ctx, zone, kind); err != nil {
+			return nil, err
+		}
+	}
+
+	return &placement{id: applicationID, tenant: requestTenant(request), tier: tier, zones: zones, selections: selections, decision: decision}, nil
+}
+
+// createWithinQuotas stores app unless it would exceed a quota. No other application is
+// stored between the check and the creation.
+func (s *PlacementService) createWithinQuotas(ctx context.Context, app model.Application) (*model.Application, error) {
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+	if err := s.checkQuotas(ctx, app); err != nil {
+		s.recordEvent(ctx, app.ID, EventQuotaExceeded, err.Error())
+		return nil, err
+	}
+	return s.store.Application().Create(ctx, app)
+}
+
+// PolicyRejectedError is returned when the policy does not allow an application.
+type PolicyRejectedError struct {
+	Reason string
+}
+
+func (e *PolicyRejectedError) Error() string {
+	return e.Reason
+}
+
+// requestTenant returns the tenant of the application, DefaultTenant when unset.
+func requestTenant(request *server.CreateApplicationJSONRequestBody) string {
+	if request.Tenant != nil && *request.Tenant != "" {
+		return *request.Tenant
+	}
+	return DefaultTenant
+}
+
+// evaluation configures evaluate. The zero value evaluates a new application with the policy
+// engine of the service.
+type evaluation struct {
+	// engine replaces the policy engine of the service
+	engine opa.Engine
+	// current is the stored application being evaluated again, left out of the capacity,
+	// affinity and spread inputs so it does not compete with itself
+	current *model.Application
+	// id of the new application, matched by the anti-affinity of the other applications
+	id uuid.UUID
+	// environment the application is placed in, the one of current when unset
+	environment string
+	// replicas the application runs in each zone, the replicas of the catalog when 0
+	replicas int
+}
+
+// evaluate asks the policy of tier for the candidate zones of the application, then selects
+// the zones it is placed in. Every candidate is returned with the reason of its selection,
+// along with the decision of the policy.
+func (s *PlacementService) evaluate(ctx context.Context, request *server.CreateApplicationJSONRequestBody, tier *model.Tier, eval evaluation) (*model.PlacementDecision, []model.ZoneSelection, error) {
+	logger := zap.S().Named("placement_service:evaluate")
+
+	engine := s.opa
+	if eval.engine != nil {
+		engine = eval.engine
+	}
+	id := eval.id
+	if eval.current != nil {
+		id = eval.current.ID
+	}
+
+	// OPA validation:
+	usage, err := s.ledger.Usage(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if eval.current != nil {
+		usage = capacity.Without(usage, *eval.current)
+	}
+	affinity, antiAffinity, err := s.affinityInputs(ctx, request, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Cordoned and unhealthy zones are neither offered to nor accepted from the policy
+	unschedulable, err := s.unschedulableZones(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	available, err := s.availableZones(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	available = slices.DeleteFunc(available, func(zone opa.AvailableZone) bool { return unschedulable[zone.Name] })
+	input := opa.TierInput{
+		Name:         request.Name,
+		Zones:        request.Zones,
+		Service:      string(request.Service),
+		Requirements: catalog.GetScaledRequirements(request.Service, eval.replicas),
+		Capacity:     usage,
+		Affinity:     affinity,
+		AntiAffinity: antiAffinity,
+		Labels:       optionalMap(request.Labels),
+		Annotations:  optionalMap(request.Annotations),
+		// Policies pick zones among the provider namespaces instead of looking them up
+		AvailableZones: available,
+		Environment:    eval.environment,
+	}
+	if eval.current != nil {
+		if input.Environment == "" {
+			input.Environment = eval.current.Environment
+		}
+		if eval.replicas == 0 {
+			input.Requirements = catalog.GetScaledRequirements(request.Service, eval.current.Replicas)
+		}
+	}
+
+	logger.Info("Evaluating policy: ", "Tier: ", fmt.Sprintf("%d", tier.ID), " Policy: ", tier.PolicyPath)
+	decision, err := engine.EvalTierPolicy(ctx, tier.PolicyPath, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := decision.Result
+	record := decisionRecord(tier, input, decision)
 
 	logger.Info("OPA validation result: ", "Result: ", result)
 
-	if !s.opa.IsValid(result) {
-		failures := s.opa.GetFailures(result)
+	if !opa.IsValid(result) {
+		failures := opa.GetFailures(result)
 		if len(failures) > 0 {
-			return nil, fmt.Errorf("validation failed: %v", failures)
+			return nil, nil, &PolicyRejectedError{Reason: fmt.Sprintf("validation failed: %v", failures)}
 		}
-		return nil, fmt.Errorf("input validation failed")
+		return nil, nil, &PolicyRejectedError{Reason: "input validation failed"}
 	}
 
-	serviceType := request.Service
-	var applicationID uuid.UUID
-	if appID != "" {
-		applicationID, _ = uuid.Parse(appID)
-	} else {
-		applicationID = uuid.New()
+	candidates := slices.DeleteFunc(opa.GetCandidateZones(result), func(c opa.ZoneCandidate) bool { return unschedulable[c.Zone] })
+	if len(candidates) == 0 {
+		return nil, nil, &PolicyRejectedError{Reason: "no zones found"}
+	}
+	if request.Zones != nil && len(*request.Zones) > 0 {
+		return record, requestedZones(*request.Zones, candidates), nil
+	}
+
+	occupied, err := s.zonesHosting(ctx, request.Name, requestTenant(request), id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return record, selectZones(candidates, opa.GetReplicaZones(result), occupied), nil
+}
+
+// availableZones lists the zones of the providers for the policy input.
+func (s *PlacementService) availableZones(ctx context.Context) ([]opa.AvailableZone, error) {
+	zones, err := s.inventory.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	available := make([]opa.AvailableZone, 0, len(zones))
+	for _, zone := range zones {
+		labels := zone.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		available = append(available, opa.AvailableZone{Name: zone.Name, Provider: zone.Provider, Labels: labels})
+	}
+	return available, nil
+}
+
+// providerForZone returns the provider deploying to zone: the one listing its namespace when
+// the inventory knows the zone, the one of the configuration otherwise.
+func (s *PlacementService) providerForZone(ctx context.Context, zone string, kind provider.DeploymentRequestKind) (string, provider.Provider, error) {
+	zones, err := s.inventory.Zones(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, listed := range zones {
+		if listed.Name == zone {
+			return s.providers.ForListedZone(zone, listed.Provider, kind)
+		}
+	}
+	return s.providers.ForZone(zone, kind)
+}
+
+// zonesHosting returns the zones of the applications named name in tenant, except exclude.
+func (s *PlacementService) zonesHosting(ctx context.Context, name, tenant string, exclude uuid.UUID) (map[string]bool, error) {
+	zones := map[string]bool{}
+	err := s.eachApplication(ctx, func(app model.Application) {
+		if app.Name != name || app.Tenant != tenant || app.ID == exclude {
+			return
+		}
+		for _, zone := range app.Zones {
+			zones[zone] = true
+		}
+	})
+	return zones, err
+}
+
+// eachApplication calls fn for every stored application, following the pages.
+func (s *PlacementService) eachApplication(ctx context.Context, fn func(model.Application)) error {
+	var pageToken *string
+	for {
+		apps, next, err := s.store.Application().List(ctx, store.ApplicationFilter{}, nil, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			fn(app)
+		}
+		if next == nil {
+			return nil
+		}
+		pageToken = next
+	}
+}
+
+// PreviewPlacement evaluates where the application would be placed without creating or
+// recording anything. Rejections by the policy are reported in the preview, not as errors.
+func (s *PlacementService) PreviewPlacement(ctx context.Context, request *server.CreateApplicationJSONRequestBody) (*server.PlacementPreview, error) {
+	if err := validateLabels(request); err != nil {
+		return nil, err
+	}
+	tier, err := s.resolveTier(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateZones(ctx, request); err != nil {
+		return nil, err
+	}
+	_, selections, err := s.evaluate(ctx, request, tier, evaluation{})
+	zones := selectedZones(selections)
+	preview := &server.PlacementPreview{Tier: tier.ID}
+	var rejected *PolicyRejectedError
+	if errors.As(err, &rejected) {
+		preview.Reason = &rejected.Reason
+		return preview, nil
+	} else if err != nil {
+		return nil, err
 	}
 
-	// Store in database post validation
-	zones := s.opa.GetRequiredZones(result)
-	if len(zones) == 0 {
-		return nil, fmt.Errorf("no zones found")
+	kind := deploymentKind(request.Service)
+	deployments := make([]server.ZoneDeployment, 0, len(zones))
+	for _, zone := range zones {
+		providerName, _, err := s.providerForZone(ctx, zone, kind)
+		if err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, server.ZoneDeployment{Zone: zone, Provider: &providerName})
 	}
+	preview.Allowed = true
+	preview.Zones = &zones
+	preview.Deployments = &deployments
+	preview.Placement = optionalSlice(mappers.ZoneSelectionsToAPI(selections))
+	return preview, nil
+}
+
+// deploy stores the application and creates its deployments in the zones it was placed in.
+func (s *PlacementService) deploy(ctx context.Context, request *server.CreateApplicationJSONRequestBody, placement *placement, opts CreateOptions) (*server.ApplicationResponse, error) {
+	logger := zap.S().Named("placement_service:create_app")
+
+	tier, zones := placement.tier, placement.zones
 
 	appModel := model.Application{
-		ID:            applicationID,
+		ID:            placement.id,
 		Name:          request.Name,
 		Service:       string(request.Service),
 		Zones:         zones,
 		Tier:          tier,
+		Tenant:        placement.tenant,
+		Placement:     placement.selections,
+		Labels:        optionalMap(request.Labels),
+		Annotations:   optionalMap(request.Annotations),
+		Affinity:      selectorFromAPI(request.Affinity),
+		AntiAffinity:  selectorFromAPI(request.AntiAffinity),
 		DeploymentIDs: []string{},
+		Providers:     []string{},
+		Status:        model.ApplicationStatusDeploying,
+		Environment:   model.EnvironmentProduction,
 	}
 
-	app, err := s.store.Application().Create(ctx, appModel)
+	app, err := s.createWithinQuotas(ctx, appModel)
 	if err != nil {
 		return nil, err
 	}
+	s.recordEvent(ctx, app.ID, EventApplicationCreated, fmt.Sprintf("%s application %q created", app.Service, app.Name))
+	s.saveDecision(ctx, app.ID, placement.decision)
+	s.notify(ctx, EventApplicationCreated, app)
+	s.publish(WatchApplicationCreated, app, nil)
 
-	// Deploy to provider service
-	var deploymentIDs []string
+	// Deploy to the provider serving each zone
+	var deployments []deploymentRef
 	for _, zone := range zones {
-		logger.Info("Creating deployment in Zone: ", "Zone: ", zone)
-		var deploymentID string
-
-		if serviceType == "webserver" {
-			vm := catalog.GetCatalogVm(request.Service)
-			deploymentID, err = s.providerService.CreateVMDeployment(ctx, request.Name, zone, vm, app.ID.String())
-			if err != nil {
-				// Rollback: delete already created deployments
-				for _, id := range deploymentIDs {
-					_ = s.providerService.DeleteDeployment(ctx, id)
-				}
-				_ = s.store.Application().Delete(ctx, app.ID)
-				return nil, fmt.Errorf("failed to create VM deployment in zone %s: %w", zone, err)
-			}
-		} else if serviceType == "container" {
-			containerApp := catalog.GetContainerApp()
-			deploymentID, err = s.providerService.CreateContainerDeployment(ctx, request.Name, zone, containerApp, app.ID.String())
-			if err != nil {
-				// Rollback: delete already created deployments
-				for _, id := range deploymentIDs {
-					_ = s.providerService.DeleteDeployment(ctx, id)
-				}
-				_ = s.store.Application().Delete(ctx, app.ID)
-				return nil, fmt.Errorf("failed to create container deployment in zone %s: %w", zone, err)
-			}
+		d, err := s.createDeployment(ctx, app, zone)
+		if err != nil {
+			// Rollback: delete already created deployments
+			s.rollback(ctx, app, deployments, err)
+			return nil, err
 		}
-
-		deploymentIDs = append(deploymentIDs, deploymentID)
+		deployments = append(deployments, d)
 	}
 
 	// Update application with deployment IDs
-	app.DeploymentIDs = deploymentIDs
+	for _, d := range deployments {
+		app.DeploymentIDs = append(app.DeploymentIDs, d.id)
+		app.Providers = append(app.Providers, d.provider)
+	}
 	app, err = s.store.Application().Update(ctx, *app)
 	if err != nil {
+		err = fmt.Errorf("failed to update application with deployment IDs: %w", err)
 		// Rollback: delete deployments
-		for _, id := range deploymentIDs {
-			_ = s.providerService.DeleteDeployment(ctx, id)
+		s.rollback(ctx, &appModel, deployments, err)
+		return nil, err
+	}
+	s.recordEvent(ctx, app.ID, EventApplicationDeployed, fmt.Sprintf("deployments requested in zones %v", zones))
+	s.notify(ctx, EventApplicationDeployed, app)
+	s.publish(WatchApplicationUpdated, app, nil)
+
+	var zoneDeployments []server.ZoneDeployment
+	if opts.WaitForReady {
+		timeout := opts.ReadyTimeout
+		if timeout <= 0 {
+			timeout = defaultReadyTimeout
 		}
-		return nil, fmt.Errorf("failed to update application with deployment IDs: %w", err)
+		zoneDeployments, err = s.waitForReady(ctx, app, deployments, timeout)
+		if err != nil {
+			if opts.RollbackOnFailure {
+				logger.Warnw("Application not ready, rolling back", "application", app.ID, "error", err)
+				s.rollback(ctx, app, deployments, err)
+				return nil, fmt.Errorf("application rolled back: %w", err)
+			}
+			app.Status = model.ApplicationStatusFailed
+			app.StatusMessage = err.Error()
+			s.recordEvent(ctx, app.ID, EventApplicationFailed, err.Error())
+			s.notify(ctx, EventApplicationFailed, app)
+			s.publish(WatchApplicationUpdated, app, zoneDeployments)
+			if _, updateErr := s.store.Application().Update(ctx, *app); updateErr != nil {
+				logger.Warnw("Failed to mark application as failed", "application", app.ID, "error", updateErr)
+			}
+			return nil, fmt.Errorf("application marked as failed: %w", err)
+		}
+		app.Status = model.ApplicationStatusReady
+		app.StatusMessage = ""
+		app, err = s.store.Application().Update(ctx, *app)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update application status: %w", err)
+		}
+		s.recordEvent(ctx, app.ID, EventApplicationReady, "every zone deployment is running")
+		s.publish(WatchApplicationUpdated, app, zoneDeployments)
 	}
 
 	appService := string(request.Service)
 	return &server.ApplicationResponse{
-		Name:    &request.Name,
-		Service: &appService,
-		Tier:    &tier,
-		Id:      &app.ID,
+		Name:         &request.Name,
+		Service:      &appService,
+		Tier:         &tier,
+		Tenant:       &app.Tenant,
+		Id:           &app.ID,
+		Status:       &app.Status,
+		Deployments:  optionalSlice(zoneDeployments),
+		Placement:    optionalSlice(mappers.ZoneSelectionsToAPI(app.Placement)),
+		Labels:       request.Labels,
+		Annotations:  request.Annotations,
+		Affinity:     request.Affinity,
+		AntiAffinity: request.AntiAffinity,
 	}, nil
 }
 
 func (s *PlacementService) DeleteApplication(ctx context.Context, id uuid.UUID) (*server.ApplicationResponse, error) {
 	logger := zap.S().Named("placement_service:delete_app")
+	defer s.applications.lock(id)()
 	app, err := s.store.Application().Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Delete deployments from provider service
-	for _, deploymentID := range app.DeploymentIDs {
-		logger.Info("Deleting deployment: ", "DeploymentID: ", deploymentID)
-		err = s.providerService.DeleteDeployment(ctx, deploymentID)
+	// Delete deployments from the providers owning them
+	for _, d := range applicationDeployments(app) {
+		logger.Info("Deleting deployment: ", "DeploymentID: ", d.id, " Provider: ", d.provider)
+		p, err := s.providers.Get(d.provider)
+		if err != nil {
+			logger.Warnw("Failed to resolve provider", "deploymentID", d.id, "provider", d.provider, "error", err)
+			s.recordEvent(ctx, id, EventDeploymentDeleteError, err.Error(), withDeployment(d))
+			continue
+		}
+		err = p.DeleteDeployment(ctx, d.id)
 		if err != nil {
-			logger.Warnw("Failed to delete deployment", "deploymentID", deploymentID, "error", err)
+			logger.Warnw("Failed to delete deployment", "deploymentID", d.id, "error", err)
+			s.recordEvent(ctx, id, EventDeploymentDeleteError, err.Error(), withDeployment(d))
 			// Continue deleting other deployments even if one fails
+			continue
 		}
+		s.recordEvent(ctx, id, EventDeploymentDeleted, fmt.Sprintf("deployment deleted in zone %s", d.zone), withDeployment(d))
 	}
 
 	// Delete app from database
@@ -153,6 +556,150 @@ func (s *PlacementService) DeleteApplication(ctx context.Context, id uuid.UUID)
 	if err != nil {
 		return nil, err
 	}
+	s.recordEvent(ctx, id, EventApplicationDeleted, fmt.Sprintf("application %q deleted", app.Name))
+	s.notify(ctx, EventApplicationDeleted, app)
+	s.publish(WatchApplicationDeleted, app, nil)
 
 	return mappers.ApplicationToAPI(*app), nil
 }
+
+// createDeployment creates the deployment of app in zone on the provider serving it.
+func (s *PlacementService) createDeployment(ctx context.Context, app *model.Application, zone string) (deploymentRef, error) {
+	logger := zap.S().Named("placement_service:create_app")
+	service := server.ApplicationService(app.Service)
+	labels := provider.DeploymentLabels(app.ID.String(), app.Labels)
+
+	providerName, p, err := s.providerForZone(ctx, zone, deploymentKind(service))
+	if err != nil {
+		s.recordEvent(ctx, app.ID, EventDeploymentFailed, err.Error(), withZone(zone, ""))
+		return deploymentRef{}, err
+	}
+	logger.Info("Creating deployment in Zone: ", "Zone: ", zone, " Provider: ", providerName)
+	var deploymentID string
+	if service == server.Webserver {
+		vm := catalog.GetCatalogVm(service)
+		deploymentID, err = p.CreateVMDeployment(ctx, app.Name, zone, vm, labels)
+		if err != nil {
+			err = fmt.Errorf("failed to create VM deployment in zone %s: %w", zone, err)
+		}
+	} else if service == server.Container {
+		containerApp := catalog.GetContainerApp()
+		containerApp.Replica = int32(catalog.GetScaledReplicas(service, app.ReplicasIn(zone)))
+		deploymentID, err = p.CreateContainerDeployment(ctx, app.Name, zone, containerApp, labels)
+		if err != nil {
+			err = fmt.Errorf("failed to create container deployment in zone %s: %w", zone, err)
+		}
+	}
+	if err != nil {
+		s.recordEvent(ctx, app.ID, EventDeploymentFailed, err.Error(), withZone(zone, providerName))
+		return deploymentRef{}, err
+	}
+
+	d := deploymentRef{zone: zone, provider: providerName, id: deploymentID}
+	s.recordEvent(ctx, app.ID, EventDeploymentCreated, fmt.Sprintf("deployment created in zone %s", zone), withDeployment(d))
+	return d, nil
+}
+
+// relocate moves the deployments of app to the selected zones. Deployments are created in the
+// new zones before the application is updated, and the deployments of the zones it leaves are
+// deleted last, so the application keeps running meanwhile.
+func (s *PlacementService) relocate(ctx context.Context, app *model.Application, selections []model.ZoneSelection) (*model.Application, error) {
+	zones := selectedZones(selections)
+
+	current := applicationDeployments(app)
+	deployed := map[string]bool{}
+	for _, d := range current {
+		deployed[d.zone] = true
+	}
+	var created []deploymentRef
+	for _, target := range zones {
+		if deployed[target] {
+			continue
+		}
+		d, err := s.createDeployment(ctx, app, target)
+		if err != nil {
+			s.deleteDeployments(ctx, app.ID, created)
+			return nil, err
+		}
+		created = append(created, d)
+	}
+
+	var kept, removed []deploymentRef
+	for _, d := range current {
+		if slices.Contains(zones, d.zone) {
+			kept = append(kept, d)
+		} else {
+			removed = append(removed, d)
+		}
+	}
+	app.Zones, app.DeploymentIDs, app.Providers = nil, []string{}, []string{}
+	for _, d := range append(kept, created...) {
+		app.Zones = append(app.Zones, d.zone)
+		app.DeploymentIDs = append(app.DeploymentIDs, d.id)
+		app.Providers = append(app.Providers, d.provider)
+	}
+	app.Placement = selections
+	// Replicas scaled in the zones left are not carried over to the new zones
+	maps.DeleteFunc(app.ZoneReplicas, func(zone string, _ int) bool { return !slices.Contains(zones, zone) })
+	updated, err := s.store.Application().Update(ctx, *app)
+	if err != nil {
+		s.deleteDeployments(ctx, app.ID, created)
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+	s.deleteDeployments(ctx, app.ID, removed)
+	return updated, nil
+}
+
+// rollback removes the deployments created so far and the application, recording the cause.
+func (s *PlacementService) rollback(ctx context.Context, app *model.Application, deployments []deploymentRef, cause error) {
+	s.deleteDeployments(ctx, app.ID, deployments)
+	_ = s.store.Application().Delete(ctx, app.ID)
+	s.recordEvent(ctx, app.ID, EventApplicationRolledBack, cause.Error())
+
+	failed := *app
+	failed.Status = model.ApplicationStatusFailed
+	failed.StatusMessage = cause.Error()
+	s.notify(ctx, EventApplicationFailed, &failed)
+	s.publish(WatchApplicationDeleted, &failed, nil)
+}
+
+// deleteDeployments removes the given deployments, ignoring failures. Used for rollbacks and
+// evacuations.
+func (s *PlacementService) deleteDeployments(ctx context.Context, appID uuid.UUID, deployments []deploymentRef) {
+	for _, d := range deployments {
+		p, err := s.providers.Get(d.provider)
+		if err != nil {
+			continue
+		}
+		if err := p.DeleteDeployment(ctx, d.id); err != nil {
+			s.recordEvent(ctx, appID, EventDeploymentDeleteError, err.Error(), withDeployment(d))
+			continue
+		}
+		s.recordEvent(ctx, appID, EventDeploymentDeleted, fmt.Sprintf("deployment deleted in zone %s", d.zone), withDeployment(d))
+	}
+}
+
+// applicationDeployments pairs each deployment ID of app with the provider owning it.
+// Applications stored before providers were tracked belong to the default provider.
+func applicationDeployments(app *model.Application) []deploymentRef {
+	refs := make([]deploymentRef, 0, len(app.DeploymentIDs))
+	for i, id := range app.DeploymentIDs {
+		name := provider.DefaultProviderName
+		if i < len(app.Providers) {
+			name = app.Providers[i]
+		}
+		zone := ""
+		if i < len(app.Zones) {
+			zone = app.Zones[i]
+		}
+		refs = append(refs, deploymentRef{zone: zone, provider: name, id: id})
+	}
+	return refs
+}
+
+func deploymentKind(service server.ApplicationService) provider.DeploymentRequestKind {
+	if service == server.Container {
+		return provider.DeploymentRequestKindContainer
+	}
+	return provider.DeploymentRequestKindVm
+}
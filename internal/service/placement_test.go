@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/dcm-project/dcm-placement-api/internal/config"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func newTestService(t *testing.T) (*PlacementService, store.Store, *provider.FakeProvider) {
+	t.Helper()
+	return newTestServiceWithOptions(t, provider.FakeOptions{})
+}
+
+func newTestServiceWithOptions(t *testing.T, opts provider.FakeOptions) (*PlacementService, store.Store, *provider.FakeProvider) {
+	t.Helper()
+
+	defaults, err := config.New()
+	if err != nil {
+		t.Fatalf("reading configuration: %v", err)
+	}
+	dbCfg := *defaults.Database
+	dbCfg.Type = "sqlite"
+	dbCfg.Name = fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+
+	db, err := store.InitDB(&config.Config{Database: &dbCfg})
+	if err != nil {
+		t.Fatalf("initializing store: %v", err)
+	}
+	s := store.NewStore(db)
+	t.Cleanup(func() { _ = s.Close() })
+
+	fake := provider.NewFakeProvider(opts)
+	registry := provider.NewRegistry()
+	if err := registry.Register(provider.DefaultProviderName, fake, nil, nil, true); err != nil {
+		t.Fatalf("registering provider: %v", err)
+	}
+
+	engine := opa.NewFakeEngine(map[int][]string{
+		1: {"zone-a", "zone-b"},
+		2: {"zone-c"},
+	})
+	ps := NewPlacementService(s, engine, registry)
+	ps.pollInterval = 10 * time.Millisecond
+	if err := ps.InitTiers(context.Background()); err != nil {
+		t.Fatalf("initializing tiers: %v", err)
+	}
+	return ps, s, fake
+}
+
+func TestCreateAndDeleteApplication(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Webserver, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if fake.Count() != 2 {
+		t.Fatalf("expected 2 deployments, got %d", fake.Count())
+	}
+
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(stored.DeploymentIDs) != 2 || len(stored.Providers) != 2 {
+		t.Fatalf("expected 2 deployments recorded, got %v / %v", stored.DeploymentIDs, stored.Providers)
+	}
+
+	if _, err := ps.DeleteApplication(ctx, *app.Id); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+	if fake.Count() != 0 {
+		t.Fatalf("expected deployments to be deleted, %d left", fake.Count())
+	}
+}
+
+func TestCreateApplicationPolicyFailure(t *testing.T) {
+	ps, _, fake := newTestService(t)
+
+	zones := []string{"zone-z"}
+	_, err := ps.CreateApplication(context.Background(), &server.Application{Name: "web", Service: server.Webserver, Zones: &zones}, "", CreateOptions{})
+	if err == nil {
+		t.Fatal("expected policy validation to fail")
+	}
+	if fake.Count() != 0 {
+		t.Fatalf("expected no deployment, got %d", fake.Count())
+	}
+}
+
+func TestCreateApplicationRollback(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	tier := 1
+	fake.FailNext("create", errors.New("boom"))
+	// The first zone fails, nothing must be left behind
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{}); err == nil {
+		t.Fatal("expected deployment failure")
+	}
+	if fake.Count() != 0 {
+		t.Fatalf("expected rollback of deployments, %d left", fake.Count())
+	}
+	apps, _, err := s.Application().List(ctx, store.ApplicationFilter{}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(apps) != 0 {
+		t.Fatalf("expected application to be rolled back, got %d", len(apps))
+	}
+}
+
+func TestCreateApplicationWaitForReady(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestServiceWithOptions(t, provider.FakeOptions{ReadyAfter: 50 * time.Millisecond})
+
+	tier := 1
+	opts := CreateOptions{WaitForReady: true, ReadyTimeout: time.Second, RollbackOnFailure: true}
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", opts)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if app.Status == nil || *app.Status != "ready" {
+		t.Fatalf("expected ready status, got %v", app.Status)
+	}
+	if app.Deployments == nil || len(*app.Deployments) != 2 {
+		t.Fatalf("expected 2 zone deployments, got %v", app.Deployments)
+	}
+	for _, d := range *app.Deployments {
+		if d.Phase == nil || *d.Phase != string(provider.DeploymentStatusPhaseRunning) {
+			t.Fatalf("expected zone %s to be running, got %v", d.Zone, d.Phase)
+		}
+	}
+}
+
+func TestCreateApplicationReadyTimeout(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestServiceWithOptions(t, provider.FakeOptions{ReadyAfter: time.Hour})
+
+	tier := 2
+	body := &server.Application{Name: "web", Service: server.Container, Tier: &tier}
+
+	// Without rollback the application is kept and marked as failed
+	if _, err := ps.CreateApplication(ctx, body, "", CreateOptions{WaitForReady: true, ReadyTimeout: 50 * time.Millisecond}); err == nil {
+		t.Fatal("expected readiness timeout")
+	}
+	if fake.Count() != 1 {
+		t.Fatalf("expected deployment to be kept, got %d", fake.Count())
+	}
+
+	// With rollback nothing is left behind
+	if _, err := ps.CreateApplication(ctx, body, "", CreateOptions{WaitForReady: true, ReadyTimeout: 50 * time.Millisecond, RollbackOnFailure: true}); err == nil {
+		t.Fatal("expected readiness timeout")
+	}
+	if fake.Count() != 1 {
+		t.Fatalf("expected rolled back deployment, got %d", fake.Count())
+	}
+	apps, _, err := s.Application().List(ctx, store.ApplicationFilter{}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(apps) != 1 {
+		t.Fatalf("expected only the failed application, got %d", len(apps))
+	}
+	if apps[0].Status != model.ApplicationStatusFailed || apps[0].StatusMessage == "" {
+		t.Fatalf("expected application marked as failed, got %q (%q)", apps[0].Status, apps[0].StatusMessage)
+	}
+}
+
+func TestApplicationEvents(t *testing.T) {
+	ps, s, _ := newTestService(t)
+	ctx := WithActor(context.Background(), "alice")
+
+	tier := 2
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := ps.DeleteApplication(ctx, *app.Id); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+
+	events, _, err := s.Event().List(ctx, store.EventFilter{ApplicationID: app.Id}, nil, nil)
+	if err != nil {
+		t.Fatalf("List events: %v", err)
+	}
+	expected := []string{
+		EventPolicyAllowed,
+		EventApplicationCreated,
+		EventDeploymentCreated,
+		EventApplicationDeployed,
+		EventDeploymentDeleted,
+		EventApplicationDeleted,
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %d: %+v", len(expected), len(events), events)
+	}
+	for i, event := range events {
+		if event.Type != expected[i] {
+			t.Fatalf("event %d: expected %s, got %s", i, expected[i], event.Type)
+		}
+		if event.Actor != "alice" {
+			t.Fatalf("event %d: expected actor alice, got %q", i, event.Actor)
+		}
+	}
+
+	// Policy rejections are recorded against the requested ID
+	zones := []string{"zone-z"}
+	rejectedID := uuid.New()
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Zones: &zones}, rejectedID.String(), CreateOptions{}); err == nil {
+		t.Fatal("expected policy validation to fail")
+	}
+	events, _, err = s.Event().List(ctx, store.EventFilter{ApplicationID: &rejectedID, Type: EventPolicyRejected}, nil, nil)
+	if err != nil {
+		t.Fatalf("List events: %v", err)
+	}
+	if len(events) != 1 || events[0].Message == "" {
+		t.Fatalf("expected a policy rejection event with its failures, got %+v", events)
+	}
+}
+
+func TestPreviewPlacement(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	tier := 1
+	preview, err := ps.PreviewPlacement(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier})
+	if err != nil {
+		t.Fatalf("PreviewPlacement: %v", err)
+	}
+	if !preview.Allowed || preview.Deployments == nil || len(*preview.Deployments) != 2 {
+		t.Fatalf("expected an allowed placement in 2 zones, got %+v", preview)
+	}
+	for _, d := range *preview.Deployments {
+		if d.Provider == nil || *d.Provider != provider.DefaultProviderName {
+			t.Fatalf("expected zone %s served by the default provider, got %v", d.Zone, d.Provider)
+		}
+	}
+
+	zones := []string{"zone-z"}
+	preview, err = ps.PreviewPlacement(ctx, &server.Application{Name: "web", Service: server.Container, Zones: &zones})
+	if err != nil {
+		t.Fatalf("PreviewPlacement: %v", err)
+	}
+	if preview.Allowed || preview.Reason == nil {
+		t.Fatalf("expected a rejection with its reason, got %+v", preview)
+	}
+
+	// Nothing is created nor recorded
+	if fake.Count() != 0 {
+		t.Fatalf("expected no deployment, got %d", fake.Count())
+	}
+	events, _, err := s.Event().List(ctx, store.EventFilter{}, nil, nil)
+	if err != nil {
+		t.Fatalf("List events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no event, got %+v", events)
+	}
+}
+
+func TestCreateApplicationZoneCapacity(t *testing.T) {
+	ctx := context.Background()
+	ps, _, fake := newTestService(t)
+	// Room for two containers of 2 replicas
+	ps.SetCapacities(map[string]catalog.Resources{"zone-c": {Cpu: 4, Ram: 4}})
+
+	tier := 2
+	body := &server.Application{Name: "web", Service: server.Container, Tier: &tier}
+	for i := 0; i < 2; i++ {
+		if _, err := ps.CreateApplication(ctx, body, "", CreateOptions{}); err != nil {
+			t.Fatalf("CreateApplication %d: %v", i, err)
+		}
+	}
+
+	usage, err := ps.ledger.Usage(ctx)
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+	zone := usage["zone-c"]
+	if zone.Applications != 2 || zone.Available == nil || *zone.Available != (catalog.Resources{}) {
+		t.Fatalf("expected zone-c to be full, got %+v", zone)
+	}
+
+	_, err = ps.CreateApplication(ctx, body, "", CreateOptions{})
+	var rejected *PolicyRejectedError
+	if !errors.As(err, &rejected) || !strings.Contains(rejected.Reason, "lacks capacity") {
+		t.Fatalf("expected a capacity rejection, got %v", err)
+	}
+	if fake.Count() != 2 {
+		t.Fatalf("expected 2 deployments, got %d", fake.Count())
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/capacity"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+// DefaultTenant owns the applications created without a tenant.
+const DefaultTenant = "default"
+
+// QuotaUsage is the consumption of the applications matching a quota, counting the
+// catalog specs of their service, scaled to their replicas, once per zone.
+type QuotaUsage struct {
+	Applications int
+	Cpu          int
+	Ram          int
+	Replicas     int
+}
+
+func (u QuotaUsage) add(app model.Application) QuotaUsage {
+	usage := QuotaUsage{Applications: u.Applications + 1, Cpu: u.Cpu, Ram: u.Ram, Replicas: u.Replicas}
+	for _, zone := range app.Zones {
+		requirements := capacity.Requirements(app, zone)
+		usage.Cpu += requirements.Cpu
+		usage.Ram += requirements.Ram
+		usage.Replicas += catalog.GetScaledReplicas(server.ApplicationService(app.Service), app.ReplicasIn(zone))
+	}
+	return usage
+}
+
+// QuotaExceededError is returned when an application would exceed a quota.
+type QuotaExceededError struct {
+	Quota   model.Quota
+	Reasons []string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for %s: %s", quotaScope(e.Quota), strings.Join(e.Reasons, ", "))
+}
+
+func quotaScope(q model.Quota) string {
+	switch {
+	case q.Tenant != "" && q.Tier != 0:
+		return fmt.Sprintf("tenant %q tier %d", q.Tenant, q.Tier)
+	case q.Tenant != "":
+		return fmt.Sprintf("tenant %q", q.Tenant)
+	case q.Tier != 0:
+		return fmt.Sprintf("tier %d", q.Tier)
+	}
+	return "every application"
+}
+
+// QuotaUsage returns the current usage of every quota.
+func (s *PlacementService) QuotaUsage(ctx context.Context, quotas model.QuotaList) ([]QuotaUsage, error) {
+	return s.quotaUsage(ctx, quotas, uuid.Nil)
+}
+
+// quotaUsage returns the usage of every quota, leaving out the application exclude.
+func (s *PlacementService) quotaUsage(ctx context.Context, quotas model.QuotaList, exclude uuid.UUID) ([]QuotaUsage, error) {
+	usage := make([]QuotaUsage, len(quotas))
+	err := s.eachApplication(ctx, func(app model.Application) {
+		if app.ID == exclude {
+			return
+		}
+		for i, quota := range quotas {
+			if quota.Matches(app.Tenant, app.Tier) {
+				usage[i] = usage[i].add(app)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// checkQuotas fails when the application, placed in its zones, would exceed a quota of its
+// tenant or tier. The stored version of the application, if any, is not counted, the pending
+// applications, accepted but not stored yet, are.
+func (s *PlacementService) checkQuotas(ctx context.Context, app model.Application, pending ...model.Application) error {
+	tenant, tier := app.Tenant, app.Tier
+	quotas, err := s.store.Quota().List(ctx)
+	if err != nil {
+		return err
+	}
+	var matching model.QuotaList
+	for _, quota := range quotas {
+		if quota.Matches(tenant, tier) {
+			matching = append(matching, quota)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	usage, err := s.quotaUsage(ctx, matching, app.ID)
+	if err != nil {
+		return err
+	}
+	for _, p := range pending {
+		for i, quota := range matching {
+			if quota.Matches(p.Tenant, p.Tier) {
+				usage[i] = usage[i].add(p)
+			}
+		}
+	}
+	for i, quota := range matching {
+		requested := QuotaUsage{}.add(app)
+		var reasons []string
+		reasons = appendExceeded(reasons, "applications", usage[i].Applications, requested.Applications, quota.MaxApplications)
+		reasons = appendExceeded(reasons, "cpu", usage[i].Cpu, requested.Cpu, quota.MaxCpu)
+		reasons = appendExceeded(reasons, "ram", usage[i].Ram, requested.Ram, quota.MaxRam)
+		reasons = appendExceeded(reasons, "replicas", usage[i].Replicas, requested.Replicas, quota.MaxReplicas)
+		if len(reasons) > 0 {
+			return &QuotaExceededError{Quota: quota, Reasons: reasons}
+		}
+	}
+	return nil
+}
+
+func appendExceeded(reasons []string, resource string, used, requested int, limit *int) []string {
+	if limit == nil || used+requested <= *limit {
+		return reasons
+	}
+	return append(reasons, fmt.Sprintf("%s %d used + %d requested > %d", resource, used, requested, *limit))
+}
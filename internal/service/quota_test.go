@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func TestCreateApplicationQuota(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	// team-a may run 4 CPUs in tier 1, every tenant may run 2 applications
+	maxCpu, maxApps := 4, 2
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), Tenant: "team-a", Tier: 1, MaxCpu: &maxCpu}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxApplications: &maxApps}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+
+	tier := 1
+	teamA := "team-a"
+	// A webserver in the 2 zones of tier 1 reserves 2 CPUs
+	webserver := &server.Application{Name: "web", Service: server.Webserver, Tier: &tier, Tenant: &teamA}
+	app, err := ps.CreateApplication(ctx, webserver, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if app.Tenant == nil || *app.Tenant != "team-a" {
+		t.Fatalf("expected tenant team-a, got %v", app.Tenant)
+	}
+
+	// A container in the same zones reserves 4 more CPUs
+	container := &server.Application{Name: "api", Service: server.Container, Tier: &tier, Tenant: &teamA}
+	_, err = ps.CreateApplication(ctx, container, "", CreateOptions{})
+	var exceeded *QuotaExceededError
+	if !errors.As(err, &exceeded) || !strings.Contains(err.Error(), "cpu 2 used + 4 requested > 4") {
+		t.Fatalf("expected the cpu quota to be exceeded, got %v", err)
+	}
+	if fake.Count() != 2 {
+		t.Fatalf("expected no deployment for the rejected application, got %d", fake.Count())
+	}
+
+	// Other tenants only count against the global quota
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "api", Service: server.Container, Tier: &tier}, "", CreateOptions{}); err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	_, err = ps.CreateApplication(ctx, &server.Application{Name: "db", Service: server.Container}, "", CreateOptions{})
+	if !errors.As(err, &exceeded) || !strings.Contains(err.Error(), "applications 2 used + 1 requested > 2") {
+		t.Fatalf("expected the applications quota to be exceeded, got %v", err)
+	}
+
+	events, _, err := s.Event().List(ctx, store.EventFilter{Type: EventQuotaExceeded}, nil, nil)
+	if err != nil {
+		t.Fatalf("List events: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 quota events, got %d", len(events))
+	}
+}
+
+func TestCreateApplicationQuotaInBatch(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	maxApps := 2
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxApplications: &maxApps}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+
+	// Each item fits on its own, the third one does not once the first two are counted
+	results := ps.BatchCreateApplications(ctx, batchItems(nil, nil, nil), BatchOptions{Atomic: true})
+	expectStatuses(t, results, BatchItemSkipped, BatchItemSkipped, BatchItemFailed)
+	var exceeded *QuotaExceededError
+	if !errors.As(results[2].Err, &exceeded) || !strings.Contains(results[2].Err.Error(), "applications 2 used + 1 requested > 2") {
+		t.Fatalf("expected the applications quota to be exceeded, got %v", results[2].Err)
+	}
+	if fake.Count() != 0 {
+		t.Fatalf("expected no deployment, got %d", fake.Count())
+	}
+}
+
+func TestCreateApplicationQuotaConcurrent(t *testing.T) {
+	ctx := context.Background()
+	ps, s, _ := newTestService(t)
+
+	maxApps := 2
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxApplications: &maxApps}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+
+	results := ps.BatchCreateApplications(ctx, batchItems(nil, nil, nil, nil, nil, nil), BatchOptions{Concurrency: 6})
+	succeeded := 0
+	for _, r := range results {
+		if r.Status == BatchItemSucceeded {
+			succeeded++
+		}
+	}
+	if succeeded != maxApps {
+		t.Fatalf("expected %d applications created, got %v", maxApps, statuses(results))
+	}
+}
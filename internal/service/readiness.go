@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReadyTimeout = 5 * time.Minute
+	readyPollInterval   = 2 * time.Second
+)
+
+// waitForReady polls the providers until every deployment is running or succeeded.
+// It fails as soon as one deployment reports the failed phase, or when timeout expires.
+// Phase changes are streamed to the watchers of app.
+func (s *PlacementService) waitForReady(ctx context.Context, app *model.Application, deployments []deploymentRef, timeout time.Duration) ([]server.ZoneDeployment, error) {
+	logger := zap.S().Named("placement_service:wait_for_ready")
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	var previous []server.ZoneDeployment
+	for {
+		statuses, ready, err := s.deploymentStatuses(ctx, deployments)
+		if phasesChanged(previous, statuses) {
+			s.publish(WatchDeploymentPhase, app, statuses)
+		}
+		previous = statuses
+		if err != nil {
+			return statuses, err
+		}
+		if ready {
+			return statuses, nil
+		}
+		logger.Debugw("Waiting for deployments", "statuses", statuses)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return statuses, fmt.Errorf("deployments not ready after %s: %s", timeout, pendingZones(statuses))
+			}
+			return statuses, ctx.Err()
+		}
+	}
+}
+
+// deploymentStatuses fetches the status of every deployment and reports whether all are ready.
+// Transient provider errors leave the zone in the unknown phase so polling continues.
+func (s *PlacementService) deploymentStatuses(ctx context.Context, deployments []deploymentRef) ([]server.ZoneDeployment, bool, error) {
+	statuses := make([]server.ZoneDeployment, 0, len(deployments))
+	allReady := true
+	for _, d := range deployments {
+		status := zoneDeployment(d)
+		phase := provider.DeploymentStatusPhaseUnknown
+
+		p, err := s.providers.Get(d.provider)
+		if err != nil {
+			return nil, false, err
+		}
+		deployment, err := p.GetDeployment(ctx, d.id)
+		if err != nil {
+			message := err.Error()
+			status.Message = &message
+		} else if deployment.Status != nil {
+			if deployment.Status.Phase != nil {
+				phase = *deployment.Status.Phase
+			}
+			status.Message = conditionsMessage(deployment.Status)
+			status.ReadyReplicas = deployment.Status.ReadyReplicas
+		}
+
+		phaseStr := string(phase)
+		status.Phase = &phaseStr
+		statuses = append(statuses, status)
+
+		switch phase {
+		case provider.DeploymentStatusPhaseRunning, provider.DeploymentStatusPhaseSucceeded:
+		case provider.DeploymentStatusPhaseFailed:
+			message := ""
+			if status.Message != nil {
+				message = ": " + *status.Message
+			}
+			return statuses, false, fmt.Errorf("deployment %s in zone %s failed%s", d.id, d.zone, message)
+		default:
+			allReady = false
+		}
+	}
+	return statuses, allReady, nil
+}
+
+// phasesChanged reports whether the phase of any deployment differs between two polls.
+func phasesChanged(previous, current []server.ZoneDeployment) bool {
+	if len(previous) != len(current) {
+		return len(current) > 0
+	}
+	for i := range current {
+		if ptrValue(previous[i].Phase) != ptrValue(current[i].Phase) {
+			return true
+		}
+	}
+	return false
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func zoneDeployment(d deploymentRef) server.ZoneDeployment {
+	providerName := d.provider
+	deploymentID := d.id
+	return server.ZoneDeployment{
+		Zone:         d.zone,
+		Provider:     &providerName,
+		DeploymentId: &deploymentID,
+	}
+}
+
+// conditionsMessage summarizes the provider status message and the reasons of
+// conditions that are not satisfied.
+func conditionsMessage(status *provider.DeploymentStatus) *string {
+	var parts []string
+	if status.Message != nil && *status.Message != "" {
+		parts = append(parts, *status.Message)
+	}
+	if status.Conditions != nil {
+		for _, c := range *status.Conditions {
+			if c.Status == nil || *c.Status == provider.DeploymentStatusConditionsStatusTrue {
+				continue
+			}
+			part := ""
+			if c.Type != nil {
+				part = *c.Type
+			}
+			if c.Reason != nil {
+				part += " (" + *c.Reason + ")"
+			}
+			if c.Message != nil {
+				part += ": " + *c.Message
+			}
+			parts = append(parts, strings.TrimPrefix(part, ": "))
+		}
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	message := strings.Join(parts, "; ")
+	return &message
+}
+
+func pendingZones(statuses []server.ZoneDeployment) string {
+	var pending []string
+	for _, status := range statuses {
+		if status.Phase == nil {
+			continue
+		}
+		phase := provider.DeploymentStatusPhase(*status.Phase)
+		if phase != provider.DeploymentStatusPhaseRunning && phase != provider.DeploymentStatusPhaseSucceeded {
+			pending = append(pending, fmt.Sprintf("%s=%s", status.Zone, phase))
+		}
+	}
+	return strings.Join(pending, ", ")
+}
+
+// optionalSlice returns nil for an empty slice so it is omitted from responses.
+func optionalSlice[T any](items []T) *[]T {
+	if len(items) == 0 {
+		return nil
+	}
+	return &items
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReplaceApplication redeploys the application id as described by request, keeping its ID
+// and its replicas. The policy and the quotas are evaluated for the new description, then
+// every deployment is created again before the application is updated, and the former
+// deployments are deleted last, so the application keeps running when the replacement fails.
+func (s *PlacementService) ReplaceApplication(ctx context.Context, id uuid.UUID, request *server.CreateApplicationJSONRequestBody) (*server.ApplicationResponse, error) {
+	defer s.applications.lock(id)()
+	current, err := s.store.Application().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.place(ctx, request, id.String(), current)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := *current
+	replacement.Name = request.Name
+	replacement.Service = string(request.Service)
+	replacement.Tier = p.tier
+	replacement.Tenant = p.tenant
+	replacement.Labels = optionalMap(request.Labels)
+	replacement.Annotations = optionalMap(request.Annotations)
+	replacement.Affinity = selectorFromAPI(request.Affinity)
+	replacement.AntiAffinity = selectorFromAPI(request.AntiAffinity)
+	replacement.Placement = p.selections
+	replacement.Zones = p.zones
+	// Replicas scaled in the zones left are not carried over to the new zones
+	replacement.ZoneReplicas = maps.Clone(current.ZoneReplicas)
+	maps.DeleteFunc(replacement.ZoneReplicas, func(zone string, _ int) bool { return !slices.Contains(p.zones, zone) })
+	// No application is created between the quota check and the update of the application
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+	if err := s.checkQuotas(ctx, replacement); err != nil {
+		s.recordEvent(ctx, id, EventQuotaExceeded, err.Error())
+		return nil, err
+	}
+
+	var created []deploymentRef
+	for _, zone := range p.zones {
+		d, err := s.createDeployment(ctx, &replacement, zone)
+		if err != nil {
+			s.deleteDeployments(ctx, id, created)
+			return nil, err
+		}
+		created = append(created, d)
+	}
+	replacement.DeploymentIDs, replacement.Providers = []string{}, []string{}
+	for _, d := range created {
+		replacement.DeploymentIDs = append(replacement.DeploymentIDs, d.id)
+		replacement.Providers = append(replacement.Providers, d.provider)
+	}
+	replacement.Status, replacement.StatusMessage = model.ApplicationStatusDeploying, ""
+	updated, err := s.store.Application().Update(ctx, replacement)
+	if err != nil {
+		s.deleteDeployments(ctx, id, created)
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+	s.deleteDeployments(ctx, id, applicationDeployments(current))
+
+	zap.S().Named("placement_service:replace").Infow("Application replaced", "application", id, "zones", updated.Zones)
+	s.saveDecision(ctx, id, p.decision)
+	s.recordEvent(ctx, id, EventApplicationReplaced, fmt.Sprintf("%s application %q redeployed in zones %v", updated.Service, updated.Name, updated.Zones))
+	s.notify(ctx, EventApplicationReplaced, updated)
+	s.publish(WatchApplicationUpdated, updated, nil)
+	return mappers.ApplicationToAPI(*updated), nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func TestReplaceApplication(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+
+	tier1, tier2 := 1, 2
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier1}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	replaced, err := ps.ReplaceApplication(ctx, *app.Id, &server.Application{Name: "web", Service: server.Container, Tier: &tier2})
+	if err != nil {
+		t.Fatalf("ReplaceApplication: %v", err)
+	}
+	if *replaced.Id != *app.Id || *replaced.Tier != 2 || !slices.Equal(*replaced.Zones, []string{"zone-c"}) {
+		t.Fatalf("expected the application in zone-c under the same ID, got %+v", replaced)
+	}
+	if fake.Count() != 1 {
+		t.Fatalf("expected the former deployments to be deleted, got %d deployments", fake.Count())
+	}
+
+	// The application keeps its deployments when the replacement cannot be deployed
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	fake.FailNext("create", errors.New("unavailable"))
+	if _, err := ps.ReplaceApplication(ctx, *app.Id, &server.Application{Name: "web", Service: server.Container, Tier: &tier1}); err == nil {
+		t.Fatal("expected the failed deployment to be reported")
+	}
+	kept, err := s.Application().Get(ctx, *app.Id)
+	if err != nil || kept.Tier != 2 || !slices.Equal(kept.DeploymentIDs, stored.DeploymentIDs) {
+		t.Fatalf("expected the application to be kept, got %+v %v", kept, err)
+	}
+	if _, err := fake.GetDeployment(ctx, kept.DeploymentIDs[0]); err != nil || fake.Count() != 1 {
+		t.Fatalf("expected the deployment to be kept, got %d deployments: %v", fake.Count(), err)
+	}
+}
+
+func TestReplaceDuringCreateWithinQuota(t *testing.T) {
+	ctx := context.Background()
+	// The provider latency lets the changes overlap
+	ps, s, _ := newTestServiceWithOptions(t, provider.FakeOptions{Latency: 20 * time.Millisecond})
+
+	tier1, tier2 := 1, 2
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier2}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	maxReplicas := 5
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxReplicas: &maxReplicas}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+
+	// Either application fits with the other in a single zone, not with the other in two zones
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, errs[0] = ps.ReplaceApplication(ctx, *app.Id, &server.Application{Name: "web", Service: server.Container, Tier: &tier1})
+	}()
+	go func() {
+		defer wg.Done()
+		// Created while the replacement is deployed
+		time.Sleep(5 * time.Millisecond)
+		_, errs[1] = ps.CreateApplication(ctx, &server.Application{Name: "api", Service: server.Container, Tier: &tier2}, "", CreateOptions{})
+	}()
+	wg.Wait()
+	var quotaExceeded *QuotaExceededError
+	if (errs[0] == nil) == (errs[1] == nil) || (!errors.As(errs[0], &quotaExceeded) && !errors.As(errs[1], &quotaExceeded)) {
+		t.Fatalf("expected one change to exceed the quota, got %v", errs)
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// InvalidScaleError is returned when an application cannot run the requested replicas.
+type InvalidScaleError struct {
+	Reason string
+}
+
+func (e *InvalidScaleError) Error() string {
+	return "invalid scale: " + e.Reason
+}
+
+// ScaleOptions are the replicas ScaleApplication sets.
+type ScaleOptions struct {
+	// Replicas run in every zone, replacing the replicas set per zone, unchanged when 0
+	Replicas int
+	// ZoneReplicas run in some zones of the application, overriding Replicas
+	ZoneReplicas map[string]int
+}
+
+// ScaleApplication sets the replicas run by the deployments of an application. The quotas
+// and the policy are checked with the new replicas, the policy making sure every zone has
+// room for the largest of them, then the deployments whose replicas change are updated. When
+// an update fails, the deployments already updated are scaled back. The returned application
+// reports the desired and ready replicas of every deployment.
+func (s *PlacementService) ScaleApplication(ctx context.Context, id uuid.UUID, opts ScaleOptions) (*server.ApplicationResponse, error) {
+	defer s.applications.lock(id)()
+	app, err := s.store.Application().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	scaled, err := scaledApplication(app, opts)
+	if err != nil {
+		return nil, err
+	}
+	service := server.ApplicationService(app.Service)
+
+	// No application is created between the quota check and the update of the replicas
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+	if err := s.checkQuotas(ctx, *scaled); err != nil {
+		s.recordEvent(ctx, app.ID, EventQuotaExceeded, err.Error())
+		return nil, err
+	}
+	tier, err := s.store.Tier().Get(ctx, app.Tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier %d: %w", app.Tier, err)
+	}
+	request := applicationRequest(app)
+	zones := slices.Clone([]string(app.Zones))
+	request.Zones = &zones
+	largest := 0
+	for _, zone := range app.Zones {
+		largest = max(largest, catalog.GetScaledReplicas(service, scaled.ReplicasIn(zone)))
+	}
+	var rejected *PolicyRejectedError
+	if _, _, err := s.evaluate(ctx, request, tier, evaluation{current: app, replicas: largest}); errors.As(err, &rejected) {
+		s.recordEvent(ctx, app.ID, EventPolicyRejected, err.Error())
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	var statuses []server.ZoneDeployment
+	var updated []deploymentRef
+	for _, d := range applicationDeployments(app) {
+		desired := catalog.GetScaledReplicas(service, scaled.ReplicasIn(d.zone))
+		var deployment *provider.DeploymentResponse
+		if desired != catalog.GetScaledReplicas(service, app.ReplicasIn(d.zone)) {
+			deployment, err = s.scaleDeployment(ctx, scaled, d)
+			if err != nil {
+				s.recordEvent(ctx, app.ID, EventDeploymentFailed, err.Error(), withDeployment(d))
+				s.rescale(ctx, app, updated)
+				return nil, err
+			}
+			updated = append(updated, d)
+		} else {
+			deployment, err = s.getDeployment(ctx, d)
+		}
+		status := zoneDeployment(d)
+		status.DesiredReplicas = &desired
+		if err != nil {
+			message := err.Error()
+			status.Message = &message
+		} else if deployment.Status != nil {
+			status.Phase = (*string)(deployment.Status.Phase)
+			status.ReadyReplicas = deployment.Status.ReadyReplicas
+		}
+		statuses = append(statuses, status)
+	}
+
+	stored, err := s.store.Application().Update(ctx, *scaled)
+	if err != nil {
+		s.rescale(ctx, app, updated)
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+	message := fmt.Sprintf("scaled to %d replicas per zone", catalog.GetScaledReplicas(service, stored.Replicas))
+	if len(stored.ZoneReplicas) > 0 {
+		message += fmt.Sprintf(", %v in the zones scaled individually", stored.ZoneReplicas)
+	}
+	zap.S().Named("placement_service:scale").Infow("Application scaled", "application", id, "replicas", stored.Replicas, "zone_replicas", stored.ZoneReplicas)
+	s.recordEvent(ctx, app.ID, EventApplicationScaled, message)
+	s.notify(ctx, EventApplicationScaled, stored)
+	s.publish(WatchApplicationUpdated, stored, statuses)
+
+	response := mappers.ApplicationToAPI(*stored)
+	response.Deployments = optionalSlice(statuses)
+	return response, nil
+}
+
+// scaledApplication returns a copy of app with the replicas of opts, checking they can be run.
+func scaledApplication(app *model.Application, opts ScaleOptions) (*model.Application, error) {
+	if opts.Replicas < 0 {
+		return nil, &InvalidScaleError{Reason: fmt.Sprintf("replicas must be positive, got %d", opts.Replicas)}
+	}
+	if opts.Replicas == 0 && len(opts.ZoneReplicas) == 0 {
+		return nil, &InvalidScaleError{Reason: "no replicas requested"}
+	}
+	scaled := *app
+	scaled.ZoneReplicas = maps.Clone(app.ZoneReplicas)
+	if opts.Replicas > 0 {
+		scaled.Replicas = opts.Replicas
+		scaled.ZoneReplicas = nil
+	}
+	for zone, replicas := range opts.ZoneReplicas {
+		if !slices.Contains(app.Zones, zone) {
+			return nil, &InvalidScaleError{Reason: fmt.Sprintf("zone %s does not host the application", zone)}
+		}
+		if replicas < 1 {
+			return nil, &InvalidScaleError{Reason: fmt.Sprintf("replicas must be positive, got %d in zone %s", replicas, zone)}
+		}
+		if scaled.ZoneReplicas == nil {
+			scaled.ZoneReplicas = map[string]int{}
+		}
+		scaled.ZoneReplicas[zone] = replicas
+	}
+	if catalog.GetCatalogVm(server.ApplicationService(app.Service)) != nil {
+		for _, zone := range app.Zones {
+			if scaled.ReplicasIn(zone) > 1 {
+				return nil, &InvalidScaleError{Reason: fmt.Sprintf("%s applications run a single virtual machine per zone", app.Service)}
+			}
+		}
+	}
+	return &scaled, nil
+}
+
+// scaleDeployment updates the deployment d of app to run the replicas of its zone.
+func (s *PlacementService) scaleDeployment(ctx context.Context, app *model.Application, d deploymentRef) (*provider.DeploymentResponse, error) {
+	p, err := s.providers.Get(d.provider)
+	if err != nil {
+		return nil, err
+	}
+	containerApp := catalog.GetContainerApp()
+	containerApp.Replica = int32(catalog.GetScaledReplicas(server.ApplicationService(app.Service), app.ReplicasIn(d.zone)))
+	req, err := provider.ContainerDeploymentRequest(app.Name, d.zone, containerApp, provider.DeploymentLabels(app.ID.String(), app.Labels))
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := p.UpdateDeployment(ctx, d.id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale deployment %s in zone %s: %w", d.id, d.zone, err)
+	}
+	return deployment, nil
+}
+
+// rescale brings the deployments back to the replicas of app after a failed scaling.
+func (s *PlacementService) rescale(ctx context.Context, app *model.Application, deployments []deploymentRef) {
+	logger := zap.S().Named("placement_service:scale")
+	for _, d := range deployments {
+		if _, err := s.scaleDeployment(ctx, app, d); err != nil {
+			logger.Warnw("Failed to scale deployment back", "application", app.ID, "deployment", d.id, "error", err)
+		}
+	}
+}
+
+func (s *PlacementService) getDeployment(ctx context.Context, d deploymentRef) (*provider.DeploymentResponse, error) {
+	p, err := s.providers.Get(d.provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetDeployment(ctx, d.id)
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/catalog"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func TestScaleApplication(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	ps.SetCapacities(map[string]catalog.Resources{"zone-a": {Cpu: 6, Ram: 6}})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	scaled, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 3, ZoneReplicas: map[string]int{"zone-b": 5}})
+	if err != nil {
+		t.Fatalf("ScaleApplication: %v", err)
+	}
+	if *scaled.Replicas != 3 || (*scaled.ZoneReplicas)["zone-b"] != 5 || len(*scaled.Deployments) != 2 {
+		t.Fatalf("unexpected scaled application %+v", scaled)
+	}
+	for _, d := range *scaled.Deployments {
+		expected := map[string]int{"zone-a": 3, "zone-b": 5}[d.Zone]
+		if *d.DesiredReplicas != expected || d.ReadyReplicas == nil || *d.ReadyReplicas != expected {
+			t.Fatalf("expected %d replicas in zone %s, got desired %v ready %v", expected, d.Zone, *d.DesiredReplicas, d.ReadyReplicas)
+		}
+		deployment, err := fake.GetDeployment(ctx, *d.DeploymentId)
+		if err != nil {
+			t.Fatalf("GetDeployment: %v", err)
+		}
+		container, err := deployment.Spec.AsContainerSpec()
+		if err != nil || *container.Container.Replicas != expected {
+			t.Fatalf("expected the deployment of zone %s to be updated, got %+v %v", d.Zone, container, err)
+		}
+	}
+
+	// Zone-a lacks the capacity of 7 replicas once its 3 are released
+	var rejected *PolicyRejectedError
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{ZoneReplicas: map[string]int{"zone-a": 7}}); !errors.As(err, &rejected) {
+		t.Fatalf("expected the policy to reject 7 replicas, got %v", err)
+	}
+
+	maxReplicas := 10
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxReplicas: &maxReplicas}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+	var quotaExceeded *QuotaExceededError
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 6}); !errors.As(err, &quotaExceeded) {
+		t.Fatalf("expected 12 replicas to exceed the quota, got %v", err)
+	}
+	var invalid *InvalidScaleError
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{ZoneReplicas: map[string]int{"zone-x": 2}}); !errors.As(err, &invalid) {
+		t.Fatalf("expected a zone without the application to be rejected, got %v", err)
+	}
+
+	// The replicas are kept when a deployment cannot be updated
+	fake.FailNext("update", errors.New("unavailable"))
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 4}); err == nil {
+		t.Fatal("expected the failed update to be reported")
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil || stored.Replicas != 3 || stored.ReplicasIn("zone-b") != 5 {
+		t.Fatalf("expected the replicas to be kept, got %+v %v", stored, err)
+	}
+}
+
+func TestScaleVirtualMachine(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestService(t)
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "vm", Service: server.Webserver, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	var invalid *InvalidScaleError
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 2}); !errors.As(err, &invalid) {
+		t.Fatalf("expected virtual machines not to scale, got %v", err)
+	}
+	if _, err := ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 1}); err != nil {
+		t.Fatalf("ScaleApplication: %v", err)
+	}
+}
+
+func TestScaleDuringFailover(t *testing.T) {
+	ctx := context.Background()
+	// The provider latency lets the changes overlap
+	ps, s, _ := newTestServiceWithOptions(t, provider.FakeOptions{Latency: 20 * time.Millisecond})
+	ps.opa.(*opa.FakeEngine).SetBackupZones(1, []string{"zone-d"})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	// Both changes are kept whatever their order
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, errs[0] = ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 3})
+	}()
+	go func() {
+		defer wg.Done()
+		_, errs[1] = ps.Failover(ctx, *app.Id, FailoverOptions{})
+	}()
+	wg.Wait()
+	if errs[0] != nil || errs[1] != nil {
+		t.Fatalf("expected both changes to succeed, got %v", errs)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil || stored.Replicas != 3 || stored.InEnvironment() != model.EnvironmentBackup || len(stored.DeploymentIDs) != 1 {
+		t.Fatalf("expected the application scaled in the backup zone, got %+v %v", stored, err)
+	}
+}
+
+func TestScaleDuringCreateWithinQuota(t *testing.T) {
+	ctx := context.Background()
+	// The provider latency lets the changes overlap
+	ps, s, _ := newTestServiceWithOptions(t, provider.FakeOptions{Latency: 20 * time.Millisecond})
+
+	tier := 1
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	maxReplicas := 8
+	if _, err := s.Quota().Create(ctx, model.Quota{ID: uuid.New(), MaxReplicas: &maxReplicas}); err != nil {
+		t.Fatalf("Create quota: %v", err)
+	}
+
+	// Either application fits with the other unscaled, not with the other scaled to 6 replicas
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, errs[0] = ps.ScaleApplication(ctx, *app.Id, ScaleOptions{Replicas: 3})
+	}()
+	go func() {
+		defer wg.Done()
+		// Created while the deployments of the first application are scaled
+		time.Sleep(5 * time.Millisecond)
+		_, errs[1] = ps.CreateApplication(ctx, &server.Application{Name: "api", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	}()
+	wg.Wait()
+	var quotaExceeded *QuotaExceededError
+	if (errs[0] == nil) == (errs[1] == nil) || (!errors.As(errs[0], &quotaExceeded) && !errors.As(errs[1], &quotaExceeded)) {
+		t.Fatalf("expected one change to exceed the quota, got %v", errs)
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+)
+
+// zoneSuffix is the trailing index of zone names, "us-east-1" is in region "us-east".
+var zoneSuffix = regexp.MustCompile(`-[0-9]+[a-z]?$`)
+
+// zoneRegion returns the region of a candidate, derived from the zone name when the
+// policy does not set it.
+func zoneRegion(candidate opa.ZoneCandidate) string {
+	if candidate.Region != "" {
+		return candidate.Region
+	}
+	return zoneSuffix.ReplaceAllString(candidate.Zone, "")
+}
+
+// selectZones picks count zones among the candidates, all of them when count is not positive.
+// Candidates are ranked by score, then:
+//   - spread: a region is only used twice once every region of the candidates is used,
+//   - anti-affinity: zones in occupied, already hosting another instance of the application,
+//     are only used when no other zone is left.
+//
+// Every candidate is returned with the reason of its selection or rejection, in rank order.
+func selectZones(candidates []opa.ZoneCandidate, count int, occupied map[string]bool) []model.ZoneSelection {
+	ranked := slices.Clone(candidates)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Zone < ranked[j].Zone
+	})
+	if count <= 0 || count > len(ranked) {
+		count = len(ranked)
+	}
+
+	selections := make([]model.ZoneSelection, len(ranked))
+	for i, c := range ranked {
+		selections[i] = model.ZoneSelection{Zone: c.Zone, Region: zoneRegion(c), Score: c.Score}
+	}
+
+	usedRegions := map[string]bool{}
+	selected := 0
+	pick := func(i int, why string) {
+		selections[i].Selected = true
+		selections[i].Reason = candidateReason(ranked[i], i, why)
+		usedRegions[selections[i].Region] = true
+		selected++
+	}
+
+	// Preferred zones first, then relax spread, then anti-affinity
+	passes := []struct {
+		allowed func(i int) bool
+		why     func(region string) string
+	}{
+		{
+			func(i int) bool { return !occupied[ranked[i].Zone] && !usedRegions[selections[i].Region] },
+			func(region string) string { return "first zone in region " + region },
+		},
+		{
+			func(i int) bool { return !occupied[ranked[i].Zone] },
+			func(region string) string { return "region " + region + " already used, no other region left" },
+		},
+		{
+			func(i int) bool { return true },
+			func(string) string { return "already hosts the application, no other zone left" },
+		},
+	}
+	for _, pass := range passes {
+		for i := range ranked {
+			if selected == count {
+				break
+			}
+			if selections[i].Selected || !pass.allowed(i) {
+				continue
+			}
+			pick(i, pass.why(selections[i].Region))
+		}
+	}
+
+	for i := range selections {
+		if selections[i].Selected {
+			continue
+		}
+		why := fmt.Sprintf("not among the %d zones wanted", count)
+		if occupied[ranked[i].Zone] {
+			why = "already hosts the application"
+		}
+		selections[i].Reason = candidateReason(ranked[i], i, why)
+	}
+	return selections
+}
+
+// reasonRequested is the reason of the selection of the zones set by the user.
+const reasonRequested = "requested"
+
+// requestedZones records the zones set by the user, validated by the policy.
+func requestedZones(zones []string, candidates []opa.ZoneCandidate) []model.ZoneSelection {
+	selections := make([]model.ZoneSelection, 0, len(zones))
+	for _, zone := range zones {
+		selection := model.ZoneSelection{Zone: zone, Selected: true, Reason: reasonRequested}
+		for _, c := range candidates {
+			if c.Zone == zone {
+				selection.Region = zoneRegion(c)
+				selection.Score = c.Score
+			}
+		}
+		selections = append(selections, selection)
+	}
+	return selections
+}
+
+func candidateReason(c opa.ZoneCandidate, rank int, why string) string {
+	reason := fmt.Sprintf("rank %d with score %g: %s", rank+1, c.Score, why)
+	if c.Reason != "" {
+		reason += " (" + c.Reason + ")"
+	}
+	return reason
+}
+
+func selectedZones(selections []model.ZoneSelection) []string {
+	zones := []string{}
+	for _, s := range selections {
+		if s.Selected {
+			zones = append(zones, s.Zone)
+		}
+	}
+	return zones
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+)
+
+func TestSelectZones(t *testing.T) {
+	candidates := []opa.ZoneCandidate{
+		{Zone: "us-east-1", Score: 0.9},
+		{Zone: "us-east-2", Score: 0.8},
+		{Zone: "us-west-1", Score: 0.5},
+		{Zone: "eu-west-1", Score: 0.4},
+	}
+
+	tests := []struct {
+		name     string
+		count    int
+		occupied map[string]bool
+		expected []string
+	}{
+		{name: "spread over regions", count: 2, expected: []string{"us-east-1", "us-west-1"}},
+		{name: "every candidate", count: 0, expected: []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1"}},
+		{name: "reuse a region once all are used", count: 4, expected: []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1"}},
+		{name: "anti-affinity", count: 2, occupied: map[string]bool{"us-east-1": true}, expected: []string{"us-east-2", "us-west-1"}},
+		{name: "anti-affinity relaxed", count: 4, occupied: map[string]bool{"us-east-1": true}, expected: []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selections := selectZones(candidates, tt.count, tt.occupied)
+			if len(selections) != len(candidates) {
+				t.Fatalf("expected every candidate to be reported, got %d", len(selections))
+			}
+			zones := selectedZones(selections)
+			slices.Sort(zones)
+			expected := slices.Clone(tt.expected)
+			slices.Sort(expected)
+			if !slices.Equal(zones, expected) {
+				t.Fatalf("expected %v, got %v", expected, zones)
+			}
+			for _, s := range selections {
+				if s.Reason == "" {
+					t.Fatalf("zone %s has no reason", s.Zone)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateApplicationScoredZones(t *testing.T) {
+	ctx := context.Background()
+	ps, _, fake := newTestService(t)
+	ps.opa.(*opa.FakeEngine).SetCandidates(3, []opa.ZoneCandidate{
+		{Zone: "zone-a", Region: "east", Score: 3},
+		{Zone: "zone-b", Region: "east", Score: 2},
+		{Zone: "zone-c", Region: "west", Score: 1, Reason: "backup"},
+	}, 2)
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 3, PolicyPath: opa.TierPolicyPath(3)}); err != nil {
+		t.Fatalf("CreateTier: %v", err)
+	}
+
+	tier := 3
+	body := &server.Application{Name: "web", Service: server.Webserver, Tier: &tier}
+	app, err := ps.CreateApplication(ctx, body, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if fake.Count() != 2 {
+		t.Fatalf("expected 2 deployments, got %d", fake.Count())
+	}
+	if app.Placement == nil || len(*app.Placement) != 3 {
+		t.Fatalf("expected the 3 candidates in the placement, got %v", app.Placement)
+	}
+	placement := *app.Placement
+	if !placement[0].Selected || placement[1].Selected || !placement[2].Selected {
+		t.Fatalf("expected zone-a and zone-c to be spread over the regions, got %+v", placement)
+	}
+	if !strings.Contains(placement[2].Reason, "backup") {
+		t.Fatalf("expected the reason of the policy to be kept, got %q", placement[2].Reason)
+	}
+
+	// A second instance avoids the zones of the first one
+	app, err = ps.CreateApplication(ctx, body, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	var zones []string
+	for _, s := range *app.Placement {
+		if s.Selected {
+			zones = append(zones, s.Zone)
+		}
+	}
+	if !slices.Contains(zones, "zone-b") {
+		t.Fatalf("expected the free zone-b to be selected, got %v", zones)
+	}
+}
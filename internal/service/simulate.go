@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/handlers/v1alpha1/mappers"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gorm.io/gorm"
+)
+
+// SimulationOutcome is the effect of a candidate policy on a stored application.
+type SimulationOutcome string
+
+const (
+	// SimulationUnchanged means the application would keep its zones
+	SimulationUnchanged SimulationOutcome = "unchanged"
+	// SimulationMoved means the application would be placed in other zones
+	SimulationMoved SimulationOutcome = "moved"
+	// SimulationInvalid means the candidate policy would reject the application
+	SimulationInvalid SimulationOutcome = "invalid"
+	// SimulationError means the application could not be evaluated
+	SimulationError SimulationOutcome = "error"
+)
+
+// Evaluating an application reads every other one for its capacity and affinity inputs, so the
+// cost of a simulation grows with the square of the number of applications.
+const (
+	defaultSimulationLimit   = 1000
+	defaultSimulationTimeout = time.Minute
+)
+
+// SimulationLimitError is returned when there are more applications than a simulation evaluates.
+type SimulationLimitError struct {
+	Applications int
+	Limit        int
+}
+
+func (e *SimulationLimitError) Error() string {
+	return fmt.Sprintf("cannot simulate %d applications, at most %d are evaluated", e.Applications, e.Limit)
+}
+
+// ApplicationSimulation is the outcome of the simulation of an application.
+type ApplicationSimulation struct {
+	Application    model.Application
+	Outcome        SimulationOutcome
+	SimulatedZones []string
+	// Reason explains invalid and error outcomes
+	Reason string
+}
+
+// Simulate evaluates every stored application again with engine, as if it was created now
+// with its current tier, labels and affinity rules, and reports which applications would
+// keep their zones, move or be rejected. Nothing is changed. The simulation is refused when
+// there are more applications than the limit, and stopped once its timeout expires.
+func (s *PlacementService) Simulate(ctx context.Context, engine opa.Engine) ([]ApplicationSimulation, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.simulationTimeout)
+	defer cancel()
+
+	var apps []model.Application
+	if err := s.eachApplication(ctx, func(app model.Application) {
+		apps = append(apps, app)
+	}); err != nil {
+		return nil, err
+	}
+	if len(apps) > s.simulationLimit {
+		return nil, &SimulationLimitError{Applications: len(apps), Limit: s.simulationLimit}
+	}
+
+	results := make([]ApplicationSimulation, 0, len(apps))
+	for i := range apps {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("simulation stopped after %d of %d applications: %w", i, len(apps), err)
+		}
+		results = append(results, s.simulate(ctx, engine, &apps[i]))
+	}
+	return results, nil
+}
+
+func (s *PlacementService) simulate(ctx context.Context, engine opa.Engine, app *model.Application) ApplicationSimulation {
+	result := ApplicationSimulation{Application: *app}
+
+	tier, err := s.store.Tier().Get(ctx, app.Tier)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		result.Outcome = SimulationInvalid
+		result.Reason = fmt.Sprintf("tier %d is not registered", app.Tier)
+		return result
+	}
+	if err != nil {
+		result.Outcome = SimulationError
+		result.Reason = err.Error()
+		return result
+	}
+
+	_, selections, err := s.evaluate(ctx, applicationRequest(app), tier, evaluation{engine: engine, current: app})
+	var rejected *PolicyRejectedError
+	switch {
+	case errors.As(err, &rejected):
+		result.Outcome = SimulationInvalid
+		result.Reason = rejected.Reason
+		return result
+	case err != nil:
+		result.Outcome = SimulationError
+		result.Reason = err.Error()
+		return result
+	}
+
+	result.SimulatedZones = selectedZones(selections)
+	result.Outcome = SimulationMoved
+	if sameZones(app.Zones, result.SimulatedZones) {
+		result.Outcome = SimulationUnchanged
+	}
+	return result
+}
+
+// applicationRequest describes a stored application as the request that created it. Zones are
+// only set when they were requested by the user.
+func applicationRequest(app *model.Application) *server.CreateApplicationJSONRequestBody {
+	tier := app.Tier
+	tenant := app.Tenant
+	request := &server.CreateApplicationJSONRequestBody{
+		Name:         app.Name,
+		Service:      server.ApplicationService(app.Service),
+		Tier:         &tier,
+		Tenant:       &tenant,
+		Affinity:     mappers.SelectorToAPI(app.Affinity),
+		AntiAffinity: mappers.SelectorToAPI(app.AntiAffinity),
+	}
+	if len(app.Labels) > 0 {
+		request.Labels = &app.Labels
+	}
+	if len(app.Annotations) > 0 {
+		request.Annotations = &app.Annotations
+	}
+	requested := len(app.Placement) > 0
+	for _, selection := range app.Placement {
+		if selection.Selected && selection.Reason != reasonRequested {
+			requested = false
+		}
+	}
+	if requested {
+		zones := slices.Clone([]string(app.Zones))
+		request.Zones = &zones
+	}
+	return request
+}
+
+func sameZones(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+)
+
+// candidateBundle keeps tier 1 in zone-a and zone-b, moves tier 2 to zone-b and rejects
+// applications named legacy.
+var candidateBundle = &opa.Bundle{
+	Modules: map[string]string{
+		"tier1.rego": `package tier1
+
+import rego.v1
+
+valid := true
+
+required_zones := ["zone-a", "zone-b"]
+
+failures := []
+`,
+		"tier2.rego": `package tier2
+
+import rego.v1
+
+default valid := false
+
+valid if count(failures) == 0
+
+required_zones := data.t2.zones
+
+failures contains "legacy applications are retired" if input.name == "legacy"
+`,
+	},
+	Data: map[string]interface{}{"t2": map[string]interface{}{"zones": []interface{}{"zone-b"}}},
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestService(t)
+
+	tier1, tier2 := 1, 2
+	for _, app := range []server.Application{
+		{Name: "web", Service: server.Container, Tier: &tier1},
+		{Name: "api", Service: server.Container, Tier: &tier2},
+		{Name: "legacy", Service: server.Container, Tier: &tier2},
+	} {
+		if _, err := ps.CreateApplication(ctx, &app, "", CreateOptions{}); err != nil {
+			t.Fatalf("CreateApplication %s: %v", app.Name, err)
+		}
+	}
+
+	engine, err := opa.NewEmbeddedEngine(candidateBundle)
+	if err != nil {
+		t.Fatalf("NewEmbeddedEngine: %v", err)
+	}
+	simulations, err := ps.Simulate(ctx, engine)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	outcomes := map[string]ApplicationSimulation{}
+	for _, simulation := range simulations {
+		outcomes[simulation.Application.Name] = simulation
+	}
+	if web := outcomes["web"]; web.Outcome != SimulationUnchanged {
+		t.Fatalf("expected web to be unchanged, got %+v", web)
+	}
+	if api := outcomes["api"]; api.Outcome != SimulationMoved || !slices.Equal(api.SimulatedZones, []string{"zone-b"}) {
+		t.Fatalf("expected api to move to zone-b, got %+v", api)
+	}
+	if legacy := outcomes["legacy"]; legacy.Outcome != SimulationInvalid || legacy.Reason == "" {
+		t.Fatalf("expected legacy to be rejected, got %+v", legacy)
+	}
+
+	// Nothing is changed
+	apps, _, err := ps.store.Application().List(ctx, store.ApplicationFilter{}, nil, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	for _, app := range apps {
+		if app.Tier == 2 && !slices.Equal(app.Zones, []string{"zone-c"}) {
+			t.Fatalf("expected %s to stay in zone-c, got %v", app.Name, app.Zones)
+		}
+	}
+}
+
+func TestSimulateLimits(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestService(t)
+
+	tier := 1
+	for _, name := range []string{"web", "api"} {
+		if _, err := ps.CreateApplication(ctx, &server.Application{Name: name, Service: server.Container, Tier: &tier}, "", CreateOptions{}); err != nil {
+			t.Fatalf("CreateApplication %s: %v", name, err)
+		}
+	}
+	engine, err := opa.NewEmbeddedEngine(candidateBundle)
+	if err != nil {
+		t.Fatalf("NewEmbeddedEngine: %v", err)
+	}
+
+	ps.SetSimulationLimits(1, time.Minute)
+	var tooMany *SimulationLimitError
+	if _, err := ps.Simulate(ctx, engine); !errors.As(err, &tooMany) || tooMany.Applications != 2 {
+		t.Fatalf("expected the simulation to be refused, got %v", err)
+	}
+
+	ps.SetSimulationLimits(2, time.Nanosecond)
+	if _, err := ps.Simulate(ctx, engine); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the simulation to time out, got %v", err)
+	}
+}
+
+func TestLoadBundle(t *testing.T) {
+	bundle, err := opa.LoadBundle("../../policies")
+	if err != nil {
+		t.Fatalf("LoadBundle: %v", err)
+	}
+	engine, err := opa.NewEmbeddedEngine(bundle)
+	if err != nil {
+		t.Fatalf("NewEmbeddedEngine: %v", err)
+	}
+	for tier := 1; tier <= 2; tier++ {
+		if exists, err := engine.PolicyExists(context.Background(), opa.TierPolicyPath(tier)); err != nil || !exists {
+			t.Fatalf("expected the policy of tier %d to be in the bundle, got %v %v", tier, exists, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultTiers are registered when the registry is empty, matching the sample policies.
+var DefaultTiers = model.TierList{
+	{ID: 1, PolicyPath: opa.TierPolicyPath(1), Description: "Production applications deployed in every zone of the tier"},
+	{ID: 2, PolicyPath: opa.TierPolicyPath(2), Default: true, Description: "Applications deployed in a single zone"},
+}
+
+// InvalidTierError is returned when an application requests a tier it cannot be placed in,
+// or when a tier being registered is invalid.
+type InvalidTierError struct {
+	Reason string
+}
+
+func (e *InvalidTierError) Error() string {
+	return e.Reason
+}
+
+// InitTiers registers DefaultTiers when no tier is registered, then checks that the policy of
+// every tier is served by the policy engine.
+func (s *PlacementService) InitTiers(ctx context.Context) error {
+	tiers, err := s.store.Tier().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tiers: %w", err)
+	}
+	if len(tiers) == 0 {
+		for _, tier := range DefaultTiers {
+			if _, err := s.store.Tier().Create(ctx, tier); err != nil {
+				return fmt.Errorf("failed to register tier %d: %w", tier.ID, err)
+			}
+		}
+		zap.S().Named("placement_service").Infof("Registered the default tiers")
+		tiers = DefaultTiers
+	}
+
+	var missing []string
+	for _, tier := range tiers {
+		exists, err := s.opa.PolicyExists(ctx, tier.PolicyPath)
+		if err != nil {
+			return fmt.Errorf("failed to look up the policy of tier %d: %w", tier.ID, err)
+		}
+		if !exists {
+			missing = append(missing, fmt.Sprintf("tier %d (%s)", tier.ID, tier.PolicyPath))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("policies not served by the policy engine: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// CreateTier registers a tier after checking its policy is served by the policy engine.
+func (s *PlacementService) CreateTier(ctx context.Context, tier model.Tier) (*model.Tier, error) {
+	if tier.ID <= 0 {
+		return nil, &InvalidTierError{Reason: "tier id must be positive"}
+	}
+	if err := opa.ValidatePolicyPath(tier.PolicyPath); err != nil {
+		return nil, &InvalidTierError{Reason: err.Error()}
+	}
+	for _, service := range tier.Services {
+		switch server.ApplicationService(service) {
+		case server.Webserver, server.Container:
+		default:
+			return nil, &InvalidTierError{Reason: fmt.Sprintf("unknown service %q", service)}
+		}
+	}
+	exists, err := s.opa.PolicyExists(ctx, tier.PolicyPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, &InvalidTierError{Reason: fmt.Sprintf("policy %q is not served by the policy engine", tier.PolicyPath)}
+	}
+	return s.store.Tier().Create(ctx, tier)
+}
+
+// resolveTier returns the tier requested by the application, the default tier when unset,
+// and checks it allows the service of the application.
+func (s *PlacementService) resolveTier(ctx context.Context, request *server.CreateApplicationJSONRequestBody) (*model.Tier, error) {
+	var tier *model.Tier
+	if request.Tier != nil {
+		var err error
+		tier, err = s.store.Tier().Get(ctx, *request.Tier)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &InvalidTierError{Reason: fmt.Sprintf("unknown tier %d", *request.Tier)}
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tiers, err := s.store.Tier().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range tiers {
+			if tiers[i].Default {
+				tier = &tiers[i]
+			}
+		}
+		if tier == nil {
+			return nil, &InvalidTierError{Reason: "no tier requested and no default tier registered"}
+		}
+	}
+	if !tier.Allows(string(request.Service)) {
+		return nil, &InvalidTierError{Reason: fmt.Sprintf("tier %d does not allow %s applications", tier.ID, request.Service)}
+	}
+	return tier, nil
+}
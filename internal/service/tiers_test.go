@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gorm.io/gorm"
+)
+
+func TestTierRegistry(t *testing.T) {
+	ctx := context.Background()
+	ps, s, _ := newTestService(t)
+
+	tiers, err := s.Tier().List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(tiers) != len(DefaultTiers) {
+		t.Fatalf("expected the default tiers to be registered, got %v", tiers)
+	}
+
+	var invalid *InvalidTierError
+	unknown := 3
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &unknown}, "", CreateOptions{}); !errors.As(err, &invalid) {
+		t.Fatalf("expected an unknown tier to be rejected, got %v", err)
+	}
+
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 3, PolicyPath: "tier3"}); !errors.As(err, &invalid) {
+		t.Fatalf("expected a tier without policy to be rejected, got %v", err)
+	}
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 3, PolicyPath: "tier1", Services: []string{"database"}}); !errors.As(err, &invalid) {
+		t.Fatalf("expected an unknown service to be rejected, got %v", err)
+	}
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 1, PolicyPath: "tier1"}); !errors.Is(err, gorm.ErrDuplicatedKey) {
+		t.Fatalf("expected a registered tier to be a duplicate, got %v", err)
+	}
+
+	// A container-only default tier
+	ps.opa.(*opa.FakeEngine).SetZones(3, []string{"zone-b"})
+	if _, err := ps.CreateTier(ctx, model.Tier{ID: 3, PolicyPath: opa.TierPolicyPath(3), Default: true, Services: []string{string(server.Container)}}); err != nil {
+		t.Fatalf("CreateTier: %v", err)
+	}
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if *app.Tier != 3 {
+		t.Fatalf("expected the application to be placed in the default tier 3, got %d", *app.Tier)
+	}
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "vm", Service: server.Webserver}, "", CreateOptions{}); !errors.As(err, &invalid) {
+		t.Fatalf("expected a webserver to be rejected by a container tier, got %v", err)
+	}
+
+	previous, err := s.Tier().Get(ctx, 2)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if previous.Default {
+		t.Fatal("expected the new default tier to replace the previous one")
+	}
+}
+
+func TestInitTiersMissingPolicy(t *testing.T) {
+	ctx := context.Background()
+	ps, s, _ := newTestService(t)
+
+	if _, err := s.Tier().Create(ctx, model.Tier{ID: 5, PolicyPath: "tier5"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := ps.InitTiers(ctx); err == nil {
+		t.Fatal("expected a tier whose policy is not served to fail the validation")
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"go.uber.org/zap"
+)
+
+// Watch event types streamed to the watchers.
+const (
+	WatchApplicationCreated = "application.created"
+	WatchApplicationUpdated = "application.updated"
+	WatchApplicationDeleted = "application.deleted"
+	WatchDeploymentPhase    = "deployment.phase_changed"
+)
+
+const (
+	// watchHistorySize bounds the events kept to resume watches
+	watchHistorySize = 1024
+	// watchBufferSize bounds the events queued for a watcher before it is dropped
+	watchBufferSize = 64
+)
+
+// ErrResourceVersionExpired is returned when resuming from a version no longer in the history,
+// or from a version this server never reached, e.g. one issued before a restart.
+var ErrResourceVersionExpired = errors.New("resource version is too old, list the applications and watch again")
+
+// WatchEvent is a change to an application. ResourceVersion increases with every change.
+type WatchEvent struct {
+	ResourceVersion uint64
+	Type            string
+	Application     server.ApplicationResponse
+}
+
+// broadcaster fans application changes out to the watchers and keeps a bounded
+// history so they can resume after a disconnection.
+type broadcaster struct {
+	mu       sync.Mutex
+	version  uint64
+	history  []WatchEvent
+	watchers map[chan WatchEvent]struct{}
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{watchers: map[chan WatchEvent]struct{}{}}
+}
+
+func (b *broadcaster) publish(eventType string, app server.ApplicationResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.version++
+	event := WatchEvent{ResourceVersion: b.version, Type: eventType, Application: app}
+	b.history = append(b.history, event)
+	if len(b.history) > watchHistorySize {
+		b.history = b.history[len(b.history)-watchHistorySize:]
+	}
+
+	for ch := range b.watchers {
+		select {
+		case ch <- event:
+		default:
+			// Too slow, drop the watcher so it resumes from its last version
+			zap.S().Named("placement_service:watch").Warnw("Dropping slow watcher", "resourceVersion", event.ResourceVersion)
+			delete(b.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// watch returns the events after since, then the live events until ctx is done, along
+// with the current version. A zero since only streams live events.
+func (b *broadcaster) watch(ctx context.Context, since uint64) (<-chan WatchEvent, uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if since > b.version {
+		return nil, 0, ErrResourceVersionExpired
+	}
+	var backlog []WatchEvent
+	if since > 0 && since < b.version {
+		if len(b.history) == 0 || b.history[0].ResourceVersion > since+1 {
+			return nil, 0, ErrResourceVersionExpired
+		}
+		for _, event := range b.history {
+			if event.ResourceVersion > since {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	ch := make(chan WatchEvent, len(backlog)+watchBufferSize)
+	for _, event := range backlog {
+		ch <- event
+	}
+	b.watchers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.watchers[ch]; ok {
+			delete(b.watchers, ch)
+			close(ch)
+		}
+	}()
+	return ch, b.version, nil
+}
+
+// Watch streams the changes to applications made after resourceVersion and returns the
+// version of the latest change. The channel is closed when ctx is done or when the watcher
+// does not keep up.
+func (s *PlacementService) Watch(ctx context.Context, resourceVersion uint64) (<-chan WatchEvent, uint64, error) {
+	return s.watchers.watch(ctx, resourceVersion)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+)
+
+func receive(t *testing.T, events <-chan WatchEvent, expected ...string) []WatchEvent {
+	t.Helper()
+	var received []WatchEvent
+	for _, eventType := range expected {
+		select {
+		case event := <-events:
+			if event.Type != eventType {
+				t.Fatalf("expected %s event, got %s", eventType, event.Type)
+			}
+			received = append(received, event)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %s event", eventType)
+		}
+	}
+	return received
+}
+
+func TestWatchApplications(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ps, _, _ := newTestServiceWithOptions(t, provider.FakeOptions{ReadyAfter: 30 * time.Millisecond})
+
+	events, version, err := ps.Watch(ctx, 0)
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if version != 0 {
+		t.Fatalf("expected initial version 0, got %d", version)
+	}
+
+	tier := 2
+	opts := CreateOptions{WaitForReady: true, ReadyTimeout: time.Second}
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", opts)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := ps.DeleteApplication(ctx, *app.Id); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+
+	received := receive(t, events,
+		WatchApplicationCreated,
+		WatchApplicationUpdated,
+		WatchDeploymentPhase, // pending
+		WatchDeploymentPhase, // running
+		WatchApplicationUpdated,
+		WatchApplicationDeleted,
+	)
+	ready := received[4].Application
+	if ready.Status == nil || *ready.Status != "ready" || ready.Deployments == nil {
+		t.Fatalf("expected ready application with deployments, got %+v", ready)
+	}
+
+	// Resuming replays the events after the given version
+	resumed, _, err := ps.Watch(ctx, received[3].ResourceVersion)
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	replayed := receive(t, resumed, WatchApplicationUpdated, WatchApplicationDeleted)
+	if replayed[0].ResourceVersion != received[4].ResourceVersion {
+		t.Fatalf("expected replay from version %d, got %d", received[4].ResourceVersion, replayed[0].ResourceVersion)
+	}
+}
+
+func TestWatchExpiredResourceVersion(t *testing.T) {
+	b := newBroadcaster()
+	for i := 0; i < watchHistorySize+10; i++ {
+		b.publish(WatchApplicationUpdated, server.ApplicationResponse{})
+	}
+	if _, _, err := b.watch(context.Background(), 5); !errors.Is(err, ErrResourceVersionExpired) {
+		t.Fatalf("expected expired resource version, got %v", err)
+	}
+	if _, _, err := b.watch(context.Background(), 20); err != nil {
+		t.Fatalf("expected resume within history, got %v", err)
+	}
+	// Versions issued before a restart are ahead of the broadcaster
+	if _, _, err := b.watch(context.Background(), uint64(watchHistorySize+11)); !errors.Is(err, ErrResourceVersionExpired) {
+		t.Fatalf("expected a version ahead of the latest change to be expired, got %v", err)
+	}
+}
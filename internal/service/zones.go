@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/opa"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"go.uber.org/zap"
+)
+
+const (
+	// regionLabel and shortRegionLabel are the namespace labels holding the region of a zone
+	regionLabel      = "topology.kubernetes.io/region"
+	shortRegionLabel = "region"
+	environmentLabel = "environment"
+
+	defaultZoneSyncInterval = time.Minute
+)
+
+// InvalidZonesError is returned when an application requests zones that cannot receive it.
+type InvalidZonesError struct {
+	Reasons []string
+}
+
+func (e *InvalidZonesError) Error() string {
+	return "invalid zones: " + strings.Join(e.Reasons, "; ")
+}
+
+// SyncZones stores the zones listed by the providers. Zones no longer listed are kept but
+// marked unhealthy. When some providers fail, the zones of the others are still stored and
+// the error is returned, the health of the zones of the failing providers being left as is.
+func (s *PlacementService) SyncZones(ctx context.Context) error {
+	listed, listErr := s.inventory.Refresh(ctx)
+	var failed []string
+	var partial *provider.ListError
+	if errors.As(listErr, &partial) {
+		failed = partial.Providers()
+	} else if listErr != nil {
+		return listErr
+	}
+	zones := make(model.ZoneList, 0, len(listed))
+	for _, zone := range listed {
+		zones = append(zones, model.Zone{
+			ID:          zone.Name,
+			Provider:    zone.Provider,
+			Region:      labelledRegion(zone.Name, zone.Labels),
+			Environment: zone.Labels[environmentLabel],
+			Labels:      zone.Labels,
+		})
+	}
+	if err := s.store.Zone().Sync(ctx, zones, time.Now(), failed...); err != nil {
+		return fmt.Errorf("failed to store the zones: %w", err)
+	}
+	return listErr
+}
+
+// RunZoneSync synchronizes the zones every interval until ctx is done, starting right away.
+func (s *PlacementService) RunZoneSync(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultZoneSyncInterval
+	}
+	logger := zap.S().Named("placement_service:zones")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.SyncZones(ctx); err != nil {
+			logger.Warnw("Failed to synchronize the zones", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// validateZones checks the zones requested by the application are known, healthy and not
+// cordoned. Requests are not checked until zones have been synchronized.
+func (s *PlacementService) validateZones(ctx context.Context, request *server.CreateApplicationJSONRequestBody) error {
+	if request.Zones == nil || len(*request.Zones) == 0 {
+		return nil
+	}
+	known, err := s.store.Zone().List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(known) == 0 {
+		return nil
+	}
+	zones := make(map[string]model.Zone, len(known))
+	for _, zone := range known {
+		zones[zone.ID] = zone
+	}
+
+	var reasons []string
+	for _, id := range *request.Zones {
+		zone, ok := zones[id]
+		switch {
+		case !ok:
+			reasons = append(reasons, fmt.Sprintf("zone %q is unknown", id))
+		case !zone.Healthy:
+			reasons = append(reasons, fmt.Sprintf("zone %q is unhealthy", id))
+		case zone.Cordoned:
+			reasons = append(reasons, fmt.Sprintf("zone %q is cordoned", id))
+		}
+	}
+	if len(reasons) > 0 {
+		return &InvalidZonesError{Reasons: reasons}
+	}
+	return nil
+}
+
+// unschedulableZones returns the synchronized zones that cannot receive new applications.
+func (s *PlacementService) unschedulableZones(ctx context.Context) (map[string]bool, error) {
+	zones, err := s.store.Zone().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unschedulable := map[string]bool{}
+	for _, zone := range zones {
+		if !zone.Schedulable() {
+			unschedulable[zone.ID] = true
+		}
+	}
+	return unschedulable, nil
+}
+
+// labelledRegion returns the region of a zone from the labels of its namespace, derived from
+// its name when unlabelled.
+func labelledRegion(name string, labels map[string]string) string {
+	for _, key := range []string{regionLabel, shortRegionLabel} {
+		if region := labels[key]; region != "" {
+			return region
+		}
+	}
+	return zoneRegion(opa.ZoneCandidate{Zone: name})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/provider"
+)
+
+func TestZoneSync(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	production := map[string]string{"environment": "production", regionLabel: "eu-west"}
+	fake.SetNamespaces([]provider.Namespace{
+		{Name: "zone-a", Labels: production},
+		{Name: "zone-b", Labels: production},
+		{Name: "zone-c-1", Labels: map[string]string{"environment": "backup"}},
+	})
+
+	// Zones are not validated before the first synchronization
+	tier := 1
+	unknown := []string{"zone-a", "zone-b", "zone-x"}
+	if _, err := ps.PreviewPlacement(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier, Zones: &unknown}); err != nil {
+		t.Fatalf("PreviewPlacement: %v", err)
+	}
+
+	if err := ps.SyncZones(ctx); err != nil {
+		t.Fatalf("SyncZones: %v", err)
+	}
+	zones, err := s.Zone().List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(zones) != 3 || zones[0].Region != "eu-west" || zones[0].Environment != "production" || zones[2].Region != "zone-c" || !zones[2].Healthy {
+		t.Fatalf("unexpected zones %+v", zones)
+	}
+
+	var invalid *InvalidZonesError
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier, Zones: &unknown}, "", CreateOptions{}); !errors.As(err, &invalid) {
+		t.Fatalf("expected an unknown zone to be rejected, got %v", err)
+	}
+	known := []string{"zone-a", "zone-b"}
+	if _, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier, Zones: &known}, "", CreateOptions{}); err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	// A failed listing keeps the zones as they are
+	fake.FailNext("namespaces", errors.New("unavailable"))
+	if err := ps.SyncZones(ctx); err == nil {
+		t.Fatal("expected the failed listing to be reported")
+	}
+	if zone, err := s.Zone().Get(ctx, "zone-b"); err != nil || !zone.Healthy {
+		t.Fatalf("expected zone-b to stay healthy, got %+v %v", zone, err)
+	}
+
+	// Zones no longer listed are unhealthy
+	fake.SetNamespaces([]provider.Namespace{{Name: "zone-a", Labels: production}})
+	if err := ps.SyncZones(ctx); err != nil {
+		t.Fatalf("SyncZones: %v", err)
+	}
+	zone, err := s.Zone().Get(ctx, "zone-b")
+	if err != nil || zone.Healthy || zone.LastSeenAt == nil {
+		t.Fatalf("expected zone-b to be unhealthy, got %+v %v", zone, err)
+	}
+	if _, err := ps.PreviewPlacement(ctx, &server.Application{Name: "api", Service: server.Container, Tier: &tier, Zones: &known}); !errors.As(err, &invalid) {
+		t.Fatalf("expected an unhealthy zone to be rejected, got %v", err)
+	}
+}
+
+func TestZoneSyncPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	edge := provider.NewFakeProvider(provider.FakeOptions{})
+	if err := ps.providers.Register("edge", edge, nil, nil, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	fake.SetNamespaces([]provider.Namespace{{Name: "zone-a"}, {Name: "zone-b"}})
+	edge.SetNamespaces([]provider.Namespace{{Name: "edge-1"}, {Name: "edge-2"}})
+	if err := ps.SyncZones(ctx); err != nil {
+		t.Fatalf("SyncZones: %v", err)
+	}
+
+	// The zones of the failing provider keep their health, the zones the other one no
+	// longer lists are unhealthy
+	fake.SetNamespaces([]provider.Namespace{{Name: "zone-a"}})
+	edge.FailNext("namespaces", errors.New("unavailable"))
+	var listErr *provider.ListError
+	if err := ps.SyncZones(ctx); !errors.As(err, &listErr) || !slices.Equal(listErr.Providers(), []string{"edge"}) {
+		t.Fatalf("expected the failure of edge to be reported, got %v", err)
+	}
+	for zone, healthy := range map[string]bool{"zone-a": true, "zone-b": false, "edge-1": true, "edge-2": true} {
+		if got, err := s.Zone().Get(ctx, zone); err != nil || got.Healthy != healthy {
+			t.Errorf("expected %s healthy to be %t, got %+v %v", zone, healthy, got, err)
+		}
+	}
+}
+
+func TestDeployToListingProvider(t *testing.T) {
+	ctx := context.Background()
+	ps, s, fake := newTestService(t)
+	// zone-c is not mapped in the configuration, so ForZone falls back to the default provider
+	edge := provider.NewFakeProvider(provider.FakeOptions{Namespaces: []provider.Namespace{{Name: "zone-c"}}})
+	if err := ps.providers.Register("edge", edge, nil, nil, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	tier := 2
+	app, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	stored, err := s.Application().Get(ctx, *app.Id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !slices.Equal(stored.Providers, []string{"edge"}) || edge.Count() != 1 || fake.Count() != 0 {
+		t.Fatalf("expected the deployment on the provider listing zone-c, got providers %v", stored.Providers)
+	}
+}
+
+func TestDeployToMappedProviderMismatch(t *testing.T) {
+	ctx := context.Background()
+	ps, _, _ := newTestService(t)
+	// A zone listed by another provider than the one it is mapped to is rejected
+	edge := provider.NewFakeProvider(provider.FakeOptions{Namespaces: []provider.Namespace{{Name: "zone-c"}}})
+	if err := ps.providers.Register("edge", edge, nil, nil, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := ps.providers.Register("pinned", provider.NewFakeProvider(provider.FakeOptions{}), nil, []string{"zone-c"}, false); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	tier := 2
+	_, err := ps.CreateApplication(ctx, &server.Application{Name: "web", Service: server.Container, Tier: &tier}, "", CreateOptions{})
+	if err == nil || !strings.Contains(err.Error(), `zone "zone-c" is mapped to provider "pinned" but listed by provider "edge"`) {
+		t.Fatalf("expected the provider mismatch to be rejected, got %v", err)
+	}
+}
@@ -16,8 +16,14 @@ const (
 	defaultPageSize = 100
 )
 
+// ApplicationFilter restricts the applications listed.
+type ApplicationFilter struct {
+	// Labels the applications must have, with the given value unless it is nil
+	Labels map[string]*string
+}
+
 type Application interface {
-	List(ctx context.Context, pageSize *int, pageToken *string) (model.ApplicationList, *string, error)
+	List(ctx context.Context, filter ApplicationFilter, pageSize *int, pageToken *string) (model.ApplicationList, *string, error)
 	Create(ctx context.Context, app model.Application) (*model.Application, error)
 	Update(ctx context.Context, app model.Application) (*model.Application, error)
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -34,7 +40,7 @@ func NewApplication(db *gorm.DB) Application {
 	return &ApplicationStore{db: db}
 }
 
-func (s *ApplicationStore) List(ctx context.Context, pageSize *int, pageToken *string) (model.ApplicationList, *string, error) {
+func (s *ApplicationStore) List(ctx context.Context, filter ApplicationFilter, pageSize *int, pageToken *string) (model.ApplicationList, *string, error) {
 	var apps model.ApplicationList
 
 	// Default page size
@@ -56,6 +62,9 @@ func (s *ApplicationStore) List(ctx context.Context, pageSize *int, pageToken *s
 
 	// Query with limit and offset
 	tx := s.db.Model(&apps).Limit(limit + 1).Offset(offset)
+	for key, value := range filter.Labels {
+		tx = whereLabel(tx, "labels", key, value)
+	}
 	result := tx.Find(&apps)
 	if result.Error != nil {
 		return nil, nil, result.Error
@@ -107,3 +116,18 @@ func (s *ApplicationStore) Get(ctx context.Context, id uuid.UUID) (*model.Applic
 	}
 	return &app, nil
 }
+
+// whereLabel requires the JSON object in column to hold key, with value unless it is nil.
+func whereLabel(tx *gorm.DB, column, key string, value *string) *gorm.DB {
+	if tx.Dialector.Name() == "postgres" {
+		if value == nil {
+			return tx.Where("jsonb_exists("+column+", ?)", key)
+		}
+		return tx.Where(column+"->>? = ?", key, *value)
+	}
+	path := `$."` + key + `"`
+	if value == nil {
+		return tx.Where("json_type("+column+", ?) IS NOT NULL", path)
+	}
+	return tx.Where("json_extract("+column+", ?) = ?", path, *value)
+}
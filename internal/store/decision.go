@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Decision interface {
+	Save(ctx context.Context, decision model.PlacementDecision) error
+	Get(ctx context.Context, applicationID uuid.UUID) (*model.PlacementDecision, error)
+}
+
+type DecisionStore struct {
+	db *gorm.DB
+}
+
+var _ Decision = (*DecisionStore)(nil)
+
+func NewDecision(db *gorm.DB) Decision {
+	return &DecisionStore{db: db}
+}
+
+// Save stores the decision of an application, replacing the decision of an earlier
+// application with the same ID.
+func (s *DecisionStore) Save(ctx context.Context, decision model.PlacementDecision) error {
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&decision).Error
+}
+
+func (s *DecisionStore) Get(ctx context.Context, applicationID uuid.UUID) (*model.PlacementDecision, error) {
+	var decision model.PlacementDecision
+	result := s.db.WithContext(ctx).First(&decision, "application_id = ?", applicationID)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &decision, nil
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gorm.io/gorm"
+)
+
+type Drain interface {
+	Create(ctx context.Context, drain model.Drain) (*model.Drain, error)
+	Update(ctx context.Context, drain model.Drain) (*model.Drain, error)
+	Latest(ctx context.Context, zoneID string) (*model.Drain, error)
+}
+
+type DrainStore struct {
+	db *gorm.DB
+}
+
+var _ Drain = (*DrainStore)(nil)
+
+func NewDrain(db *gorm.DB) Drain {
+	return &DrainStore{db: db}
+}
+
+func (s *DrainStore) Create(ctx context.Context, drain model.Drain) (*model.Drain, error) {
+	if err := s.db.WithContext(ctx).Create(&drain).Error; err != nil {
+		return nil, err
+	}
+	return &drain, nil
+}
+
+func (s *DrainStore) Update(ctx context.Context, drain model.Drain) (*model.Drain, error) {
+	if err := s.db.WithContext(ctx).Save(&drain).Error; err != nil {
+		return nil, err
+	}
+	return &drain, nil
+}
+
+// Latest returns the last drain of the zone.
+func (s *DrainStore) Latest(ctx context.Context, zoneID string) (*model.Drain, error) {
+	var drain model.Drain
+	result := s.db.WithContext(ctx).Where("zone_id = ?", zoneID).Order("created_at DESC").First(&drain)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &drain, nil
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventFilter restricts the events returned by List. Zero values match everything.
+type EventFilter struct {
+	ApplicationID *uuid.UUID
+	Type          string
+	Actor         string
+	RequestID     string
+	Since         *time.Time
+	Until         *time.Time
+}
+
+type Event interface {
+	List(ctx context.Context, filter EventFilter, pageSize *int, pageToken *string) (model.EventList, *string, error)
+	Create(ctx context.Context, event model.Event) (*model.Event, error)
+}
+
+type EventStore struct {
+	db *gorm.DB
+}
+
+var _ Event = (*EventStore)(nil)
+
+func NewEvent(db *gorm.DB) Event {
+	return &EventStore{db: db}
+}
+
+func (s *EventStore) List(ctx context.Context, filter EventFilter, pageSize *int, pageToken *string) (model.EventList, *string, error) {
+	var events model.EventList
+
+	// Default page size
+	limit := defaultPageSize
+	if pageSize != nil {
+		limit = *pageSize
+	}
+
+	// Parse page token to get offset
+	offset := 0
+	if pageToken != nil && *pageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*pageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	tx := s.db.WithContext(ctx).Model(&events)
+	if filter.ApplicationID != nil {
+		tx = tx.Where("application_id = ?", *filter.ApplicationID)
+	}
+	if filter.Type != "" {
+		tx = tx.Where("type = ?", filter.Type)
+	}
+	if filter.Actor != "" {
+		tx = tx.Where("actor = ?", filter.Actor)
+	}
+	if filter.RequestID != "" {
+		tx = tx.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.Since != nil {
+		tx = tx.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		tx = tx.Where("created_at < ?", *filter.Until)
+	}
+
+	// Oldest first, the ID breaks ties between events recorded at the same time
+	result := tx.Order("id").Limit(limit + 1).Offset(offset).Find(&events)
+	if result.Error != nil {
+		return nil, nil, result.Error
+	}
+
+	// Check if there are more results
+	var nextPageToken *string
+	if len(events) > limit {
+		events = events[:limit]
+		nextOffset := offset + limit
+		token := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		nextPageToken = &token
+	}
+
+	return events, nextPageToken, nil
+}
+
+func (s *EventStore) Create(ctx context.Context, event model.Event) (*model.Event, error) {
+	result := s.db.WithContext(ctx).Create(&event)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &event, nil
+}
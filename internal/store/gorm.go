@@ -62,6 +62,11 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	}
 	sqlDB.SetMaxIdleConns(10)
 	sqlDB.SetMaxOpenConns(100)
+	if cfg.Database.Type != "pgsql" {
+		// Connections to a shared in-memory database fail with "table is locked" instead of
+		// waiting for each other, so they are serialized
+		sqlDB.SetMaxOpenConns(1)
+	}
 
 	if cfg.Database.Type == "pgsql" {
 		var minorVersion string
@@ -76,6 +81,14 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	// FIXME: replace with proper migration system
 	if err := newDB.AutoMigrate(
 		&model.Application{},
+		&model.Event{},
+		&model.Subscription{},
+		&model.DeadLetter{},
+		&model.Quota{},
+		&model.Tier{},
+		&model.PlacementDecision{},
+		&model.Zone{},
+		&model.Drain{},
 	); err != nil {
 		zap.S().Named("gorm").Fatalf("failed to migrate database: %v", err)
 		return nil, err
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
@@ -13,7 +16,99 @@ type Application struct {
 	Service       string         `gorm:"service;not null"`
 	Zones         pq.StringArray `gorm:"type:text[]"`
 	Tier          int            `gorm:"tier;not null"`
+	Tenant        string         `gorm:"index"`
 	DeploymentIDs pq.StringArray `gorm:"type:text[]"`
+	// Providers holds the name of the provider owning each entry of DeploymentIDs
+	Providers     pq.StringArray `gorm:"type:text[]"`
+	Status        string         `gorm:"status"`
+	StatusMessage string         `gorm:"status_message"`
+	// Placement records why each candidate zone was selected or not
+	Placement    []ZoneSelection      `gorm:"serializer:json"`
+	Labels       map[string]string    `gorm:"serializer:json;type:jsonb"`
+	Annotations  map[string]string    `gorm:"serializer:json;type:jsonb"`
+	Affinity     *ApplicationSelector `gorm:"serializer:json"`
+	AntiAffinity *ApplicationSelector `gorm:"serializer:json"`
+	// Environment whose zones host the application, production unless it failed over
+	Environment string     `gorm:"environment"`
+	Failovers   []Failover `gorm:"serializer:json"`
+	// Replicas run in each zone, the replicas of the catalog when 0, unless overridden for the
+	// zone in ZoneReplicas
+	Replicas     int            `gorm:"replicas"`
+	ZoneReplicas map[string]int `gorm:"serializer:json"`
+}
+
+// ReplicasIn returns the replicas scaled in zone, 0 when the zone runs the replicas of the catalog.
+func (a Application) ReplicasIn(zone string) int {
+	if replicas, ok := a.ZoneReplicas[zone]; ok {
+		return replicas
+	}
+	return a.Replicas
+}
+
+// InEnvironment returns the environment of the application, production when unset.
+func (a Application) InEnvironment() string {
+	if a.Environment == "" {
+		return EnvironmentProduction
+	}
+	return a.Environment
+}
+
+// Failover records a move of the application between its production and backup zones.
+type Failover struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	FromZones []string  `json:"from_zones"`
+	ToZones   []string  `json:"to_zones"`
+	Reason    string    `json:"reason,omitempty"`
+	Automatic bool      `json:"automatic"`
+	At        time.Time `json:"at"`
+}
+
+// ApplicationSelector references applications by ID or by labels.
+type ApplicationSelector struct {
+	ApplicationIDs []uuid.UUID       `json:"application_ids,omitempty"`
+	MatchLabels    map[string]string `json:"match_labels,omitempty"`
 }
 
+// Matches reports whether app is one of the IDs or has every label of the selector.
+func (s ApplicationSelector) Matches(app Application) bool {
+	if slices.Contains(s.ApplicationIDs, app.ID) {
+		return true
+	}
+	if len(s.MatchLabels) == 0 {
+		return false
+	}
+	for key, value := range s.MatchLabels {
+		if actual, ok := app.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// ZoneSelection is the outcome of the placement for a candidate zone.
+type ZoneSelection struct {
+	Zone     string  `json:"zone"`
+	Region   string  `json:"region,omitempty"`
+	Score    float64 `json:"score"`
+	Selected bool    `json:"selected"`
+	Reason   string  `json:"reason"`
+}
+
+const (
+	// ApplicationStatusDeploying is set once deployments are requested from the providers
+	ApplicationStatusDeploying = "deploying"
+	// ApplicationStatusReady is set once every zone deployment is running
+	ApplicationStatusReady = "ready"
+	// ApplicationStatusFailed is set when a deployment failed or was not ready in time
+	ApplicationStatusFailed = "failed"
+)
+
+const (
+	// EnvironmentProduction is the environment of the zones applications are placed in
+	EnvironmentProduction = "production"
+	// EnvironmentBackup is the environment of the zones applications fail over to
+	EnvironmentBackup = "backup"
+)
+
 type ApplicationList []Application
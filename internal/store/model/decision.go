@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlacementDecision is the policy decision that placed an application, kept for audits.
+type PlacementDecision struct {
+	ApplicationID uuid.UUID `gorm:"primaryKey"`
+	CreatedAt     time.Time
+	Tier          int
+	Policy        string
+	// DecisionID identifies the decision in the OPA decision logs
+	DecisionID string
+	// Revision of the policy bundles that made the decision
+	Revision    string
+	Input       map[string]interface{}   `gorm:"serializer:json"`
+	Result      map[string]interface{}   `gorm:"serializer:json"`
+	Explanation []map[string]interface{} `gorm:"serializer:json"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DrainStatusRunning is set while the applications of the zone are evacuated
+	DrainStatusRunning = "running"
+	// DrainStatusCompleted is set once every application was evacuated
+	DrainStatusCompleted = "completed"
+	// DrainStatusFailed is set when some applications could not be evacuated
+	DrainStatusFailed = "failed"
+)
+
+// Statuses of the applications of a drain.
+const (
+	DrainItemPending   = "pending"
+	DrainItemEvacuated = "evacuated"
+	DrainItemFailed    = "failed"
+)
+
+// Drain records the evacuation of the applications of a zone.
+type Drain struct {
+	ID           uuid.UUID `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ZoneID       string `gorm:"index;not null"`
+	Status       string `gorm:"not null"`
+	Evacuated    int
+	Failed       int
+	Applications []DrainItem `gorm:"serializer:json"`
+	CompletedAt  *time.Time
+}
+
+// DrainItem is the evacuation of one application of a drained zone.
+type DrainItem struct {
+	ApplicationID uuid.UUID `json:"application_id"`
+	Name          string    `json:"name"`
+	Status        string    `json:"status"`
+	// Zones the application was moved to, once evacuated
+	Zones []string `json:"zones,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is an audit record of something that happened to an application.
+// Events are never updated and are kept after the application is deleted.
+type Event struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	CreatedAt     time.Time `gorm:"index"`
+	ApplicationID uuid.UUID `gorm:"index"`
+	Type          string    `gorm:"type;not null;index"`
+	Message       string    `gorm:"message"`
+	Actor         string    `gorm:"actor;index"`
+	RequestID     string    `gorm:"request_id;index"`
+	Zone          string    `gorm:"zone"`
+	Provider      string    `gorm:"provider"`
+	DeploymentID  string    `gorm:"deployment_id"`
+}
+
+type EventList []Event
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Quota limits the applications of a tenant, of a tier, or of a tier within a tenant.
+// An empty Tenant or a zero Tier matches every tenant or tier, unset limits are unlimited.
+type Quota struct {
+	ID              uuid.UUID `gorm:"primaryKey;"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Tenant          string `gorm:"uniqueIndex:idx_quota_scope;not null"`
+	Tier            int    `gorm:"uniqueIndex:idx_quota_scope;not null"`
+	MaxApplications *int   `gorm:"max_applications"`
+	MaxCpu          *int   `gorm:"max_cpu"`
+	MaxRam          *int   `gorm:"max_ram"`
+	MaxReplicas     *int   `gorm:"max_replicas"`
+}
+
+// Matches reports whether the quota applies to applications of tenant and tier.
+func (q Quota) Matches(tenant string, tier int) bool {
+	return (q.Tenant == "" || q.Tenant == tenant) && (q.Tier == 0 || q.Tier == tier)
+}
+
+type QuotaList []Quota
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Subscription registers a webhook receiving application lifecycle notifications.
+type Subscription struct {
+	ID        uuid.UUID `gorm:"primaryKey;"`
+	CreatedAt time.Time
+	URL       string `gorm:"url;not null"`
+	// EventTypes restricts the notifications delivered, empty means every type
+	EventTypes pq.StringArray `gorm:"type:text[]"`
+	// Secret signs the notifications, it is never returned after creation
+	Secret string `gorm:"secret;not null"`
+}
+
+type SubscriptionList []Subscription
+
+// DeadLetter records a notification that could not be delivered after every retry.
+type DeadLetter struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	CreatedAt      time.Time `gorm:"index"`
+	SubscriptionID uuid.UUID `gorm:"index"`
+	EventID        string    `gorm:"event_id;not null"`
+	EventType      string    `gorm:"event_type;not null"`
+	Payload        string    `gorm:"payload"`
+	Attempts       int       `gorm:"attempts"`
+	LastError      string    `gorm:"last_error"`
+}
+
+type DeadLetterList []DeadLetter
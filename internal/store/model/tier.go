@@ -0,0 +1,25 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// Tier describes a placement tier and the policy evaluated for its applications.
+type Tier struct {
+	ID          int `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PolicyPath  string `gorm:"not null"`
+	Default     bool   `gorm:"column:is_default;not null;default:false"`
+	Description string
+	// Services allowed in the tier, every service when empty
+	Services []string `gorm:"serializer:json"`
+}
+
+// Allows reports whether applications of service can be placed in the tier.
+func (t Tier) Allows(service string) bool {
+	return len(t.Services) == 0 || slices.Contains(t.Services, service)
+}
+
+type TierList []Tier
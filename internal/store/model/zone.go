@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Zone is a namespace of a provider applications can be placed in, synchronized from the
+// namespaces listed by the providers.
+type Zone struct {
+	// ID is the name of the namespace
+	ID          string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Provider    string
+	Region      string
+	Environment string
+	Labels      map[string]string `gorm:"serializer:json"`
+	// Healthy is set when the provider listed the zone at the last synchronization
+	Healthy bool `gorm:"not null"`
+	// Cordoned zones receive no new application
+	Cordoned   bool `gorm:"not null;default:false"`
+	LastSeenAt *time.Time
+}
+
+// Schedulable reports whether new applications can be placed in the zone.
+func (z Zone) Schedulable() bool {
+	return z.Healthy && !z.Cordoned
+}
+
+type ZoneList []Zone
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Quota interface {
+	List(ctx context.Context) (model.QuotaList, error)
+	Create(ctx context.Context, quota model.Quota) (*model.Quota, error)
+	Update(ctx context.Context, quota model.Quota) (*model.Quota, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*model.Quota, error)
+}
+
+type QuotaStore struct {
+	db *gorm.DB
+}
+
+var _ Quota = (*QuotaStore)(nil)
+
+func NewQuota(db *gorm.DB) Quota {
+	return &QuotaStore{db: db}
+}
+
+func (s *QuotaStore) List(ctx context.Context) (model.QuotaList, error) {
+	var quotas model.QuotaList
+	result := s.db.WithContext(ctx).Order("tenant, tier").Find(&quotas)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return quotas, nil
+}
+
+func (s *QuotaStore) Create(ctx context.Context, quota model.Quota) (*model.Quota, error) {
+	result := s.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&quota)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &quota, nil
+}
+
+// Update replaces the limits of the quota, its scope cannot change.
+func (s *QuotaStore) Update(ctx context.Context, quota model.Quota) (*model.Quota, error) {
+	result := s.db.WithContext(ctx).Model(&model.Quota{ID: quota.ID}).
+		Select("MaxApplications", "MaxCpu", "MaxRam", "MaxReplicas").
+		Updates(&quota)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.Get(ctx, quota.ID)
+}
+
+func (s *QuotaStore) Delete(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).Delete(&model.Quota{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *QuotaStore) Get(ctx context.Context, id uuid.UUID) (*model.Quota, error) {
+	var quota model.Quota
+	result := s.db.WithContext(ctx).First(&quota, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &quota, nil
+}
@@ -7,17 +7,38 @@ import (
 type Store interface {
 	Close() error
 	Application() Application
+	Event() Event
+	Subscription() Subscription
+	Quota() Quota
+	Tier() Tier
+	Decision() Decision
+	Zone() Zone
+	Drain() Drain
 }
 
 type DataStore struct {
-	db          *gorm.DB
-	application Application
+	db           *gorm.DB
+	application  Application
+	event        Event
+	subscription Subscription
+	quota        Quota
+	tier         Tier
+	decision     Decision
+	zone         Zone
+	drain        Drain
 }
 
 func NewStore(db *gorm.DB) Store {
 	return &DataStore{
-		db:          db,
-		application: NewApplication(db),
+		db:           db,
+		application:  NewApplication(db),
+		event:        NewEvent(db),
+		subscription: NewSubscription(db),
+		quota:        NewQuota(db),
+		tier:         NewTier(db),
+		decision:     NewDecision(db),
+		zone:         NewZone(db),
+		drain:        NewDrain(db),
 	}
 }
 
@@ -32,3 +53,31 @@ func (s *DataStore) Close() error {
 func (s *DataStore) Application() Application {
 	return s.application
 }
+
+func (s *DataStore) Event() Event {
+	return s.event
+}
+
+func (s *DataStore) Subscription() Subscription {
+	return s.subscription
+}
+
+func (s *DataStore) Quota() Quota {
+	return s.quota
+}
+
+func (s *DataStore) Tier() Tier {
+	return s.tier
+}
+
+func (s *DataStore) Decision() Decision {
+	return s.decision
+}
+
+func (s *DataStore) Zone() Zone {
+	return s.zone
+}
+
+func (s *DataStore) Drain() Drain {
+	return s.drain
+}
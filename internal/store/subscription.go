@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Subscription interface {
+	List(ctx context.Context) (model.SubscriptionList, error)
+	Create(ctx context.Context, subscription model.Subscription) (*model.Subscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*model.Subscription, error)
+	CreateDeadLetter(ctx context.Context, deadLetter model.DeadLetter) (*model.DeadLetter, error)
+	ListDeadLetters(ctx context.Context, subscriptionID uuid.UUID) (model.DeadLetterList, error)
+}
+
+type SubscriptionStore struct {
+	db *gorm.DB
+}
+
+var _ Subscription = (*SubscriptionStore)(nil)
+
+func NewSubscription(db *gorm.DB) Subscription {
+	return &SubscriptionStore{db: db}
+}
+
+func (s *SubscriptionStore) List(ctx context.Context) (model.SubscriptionList, error) {
+	var subscriptions model.SubscriptionList
+	result := s.db.WithContext(ctx).Order("created_at").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return subscriptions, nil
+}
+
+func (s *SubscriptionStore) Create(ctx context.Context, subscription model.Subscription) (*model.Subscription, error) {
+	result := s.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&subscription)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &subscription, nil
+}
+
+func (s *SubscriptionStore) Delete(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).Delete(&model.Subscription{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *SubscriptionStore) Get(ctx context.Context, id uuid.UUID) (*model.Subscription, error) {
+	var subscription model.Subscription
+	result := s.db.WithContext(ctx).First(&subscription, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &subscription, nil
+}
+
+func (s *SubscriptionStore) CreateDeadLetter(ctx context.Context, deadLetter model.DeadLetter) (*model.DeadLetter, error) {
+	result := s.db.WithContext(ctx).Create(&deadLetter)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &deadLetter, nil
+}
+
+func (s *SubscriptionStore) ListDeadLetters(ctx context.Context, subscriptionID uuid.UUID) (model.DeadLetterList, error) {
+	var deadLetters model.DeadLetterList
+	result := s.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID).Order("id").Find(&deadLetters)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deadLetters, nil
+}
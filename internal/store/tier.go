@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Tier interface {
+	List(ctx context.Context) (model.TierList, error)
+	Create(ctx context.Context, tier model.Tier) (*model.Tier, error)
+	Get(ctx context.Context, id int) (*model.Tier, error)
+}
+
+type TierStore struct {
+	db *gorm.DB
+}
+
+var _ Tier = (*TierStore)(nil)
+
+func NewTier(db *gorm.DB) Tier {
+	return &TierStore{db: db}
+}
+
+func (s *TierStore) List(ctx context.Context) (model.TierList, error) {
+	var tiers model.TierList
+	result := s.db.WithContext(ctx).Order("id").Find(&tiers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return tiers, nil
+}
+
+// Create stores the tier. A default tier replaces the previous default.
+func (s *TierStore) Create(ctx context.Context, tier model.Tier) (*model.Tier, error) {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tier.Default {
+			if err := tx.Model(&model.Tier{}).Where("is_default = ?", true).Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.Returning{}).Create(&tier).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tier, nil
+}
+
+func (s *TierStore) Get(ctx context.Context, id int) (*model.Tier, error) {
+	var tier model.Tier
+	result := s.db.WithContext(ctx).First(&tier, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &tier, nil
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Zone interface {
+	List(ctx context.Context) (model.ZoneList, error)
+	Get(ctx context.Context, id string) (*model.Zone, error)
+	Sync(ctx context.Context, zones model.ZoneList, seenAt time.Time, failed ...string) error
+	SetCordoned(ctx context.Context, id string, cordoned bool) (*model.Zone, error)
+}
+
+type ZoneStore struct {
+	db *gorm.DB
+}
+
+var _ Zone = (*ZoneStore)(nil)
+
+func NewZone(db *gorm.DB) Zone {
+	return &ZoneStore{db: db}
+}
+
+func (s *ZoneStore) List(ctx context.Context) (model.ZoneList, error) {
+	var zones model.ZoneList
+	result := s.db.WithContext(ctx).Order("id").Find(&zones)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return zones, nil
+}
+
+func (s *ZoneStore) Get(ctx context.Context, id string) (*model.Zone, error) {
+	var zone model.Zone
+	result := s.db.WithContext(ctx).First(&zone, "id = ?", id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &zone, nil
+}
+
+// Sync stores the zones listed by the providers as healthy and seen at seenAt, and marks the
+// other known zones unhealthy, except those of the failed providers that could not list their
+// zones. The cordon of known zones is kept.
+func (s *ZoneStore) Sync(ctx context.Context, zones model.ZoneList, seenAt time.Time, failed ...string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]string, 0, len(zones))
+		for _, zone := range zones {
+			zone.Healthy = true
+			zone.LastSeenAt = &seenAt
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"updated_at", "provider", "region", "environment", "labels", "healthy", "last_seen_at"}),
+			}).Create(&zone).Error
+			if err != nil {
+				return err
+			}
+			ids = append(ids, zone.ID)
+		}
+		missing := tx.Model(&model.Zone{}).Where("healthy = ?", true)
+		if len(ids) > 0 {
+			missing = missing.Where("id NOT IN ?", ids)
+		}
+		if len(failed) > 0 {
+			missing = missing.Where("provider NOT IN ?", failed)
+		}
+		return missing.Updates(map[string]interface{}{"healthy": false, "updated_at": seenAt}).Error
+	})
+}
+
+func (s *ZoneStore) SetCordoned(ctx context.Context, id string, cordoned bool) (*model.Zone, error) {
+	result := s.db.WithContext(ctx).Model(&model.Zone{}).Where("id = ?", id).Update("cordoned", cordoned)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.Get(ctx, id)
+}
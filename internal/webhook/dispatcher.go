@@ -0,0 +1,356 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the timestamp and the body, keyed
+	// with the subscription secret
+	SignatureHeader = "X-DCM-Signature"
+	// TimestampHeader carries the Unix time of the delivery attempt, in seconds
+	TimestampHeader = "X-DCM-Timestamp"
+	// TypePrefix namespaces the lifecycle event types in the CloudEvents type attribute
+	TypePrefix = "io.dcm.placement."
+
+	contentType = "application/cloudevents+json"
+)
+
+// ErrForbiddenAddress is returned for the subscriber URLs reaching loopback or link-local
+// addresses, which would let subscriptions call the services running next to the API or the
+// cloud metadata endpoints.
+var ErrForbiddenAddress = errors.New("loopback and link-local addresses are not allowed")
+
+// errQueueFull is recorded in the dead letters of the notifications the workers could not
+// take, rather than slowing down the API.
+var errQueueFull = errors.New("delivery queue is full")
+
+// Default size of the delivery worker pool and of its queue.
+const (
+	defaultWorkers   = 8
+	defaultQueueSize = 1000
+)
+
+// Config holds the delivery settings of the notifications.
+type Config struct {
+	Timeout      time.Duration
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	// Workers deliver the notifications concurrently, taking them from a queue of QueueSize
+	Workers   int
+	QueueSize int
+	// Source is the CloudEvents source attribute of every notification
+	Source string
+}
+
+// CloudEvent is a notification in the CloudEvents 1.0 structured JSON format.
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            any       `json:"data"`
+}
+
+// Dispatcher delivers application lifecycle notifications to the stored subscriptions.
+// Deliveries are queued to a pool of workers so they never slow down the API.
+type Dispatcher struct {
+	store  store.Store
+	cfg    Config
+	client *http.Client
+	queue  chan delivery
+	// wg tracks the queued and in-flight deliveries
+	wg sync.WaitGroup
+	// ctx is cancelled by Close to stop the deliveries waiting for a retry
+	ctx    context.Context
+	cancel context.CancelFunc
+	// mu makes queueing a delivery and closing the dispatcher exclusive
+	mu     sync.RWMutex
+	closed bool
+}
+
+// delivery is a notification queued for a subscription.
+type delivery struct {
+	subscription model.Subscription
+	event        CloudEvent
+	payload      []byte
+}
+
+func NewDispatcher(store store.Store, cfg Config) *Dispatcher {
+	// The address is checked once connected, as the name of the subscriber may resolve to
+	// another address than when the subscription was created
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: checkAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// A proxy would make the connections in place of the dialer, bypassing its check
+	transport.Proxy = nil
+
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultWorkers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		store:  store,
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout, Transport: transport},
+		queue:  make(chan delivery, cfg.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for range cfg.Workers {
+		go d.work()
+	}
+	return d
+}
+
+// Notify sends eventType about app to every subscription interested in it.
+func (d *Dispatcher) Notify(ctx context.Context, eventType string, app server.ApplicationResponse) {
+	logger := zap.S().Named("webhook")
+
+	subscriptions, err := d.store.Subscription().List(ctx)
+	if err != nil {
+		logger.Warnw("Failed to list subscriptions", "type", eventType, "error", err)
+		return
+	}
+
+	event := CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.NewString(),
+		Source:          d.cfg.Source,
+		Type:            TypePrefix + eventType,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            app,
+	}
+	if app.Path != nil {
+		event.Subject = *app.Path
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Warnw("Failed to encode notification", "type", eventType, "error", err)
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		if len(subscription.EventTypes) > 0 && !slices.Contains(subscription.EventTypes, eventType) {
+			continue
+		}
+		d.enqueue(delivery{subscription: subscription, event: event, payload: payload})
+	}
+}
+
+// enqueue hands job to the workers, recording it as a dead letter when the queue is full or
+// the dispatcher is closed.
+func (d *Dispatcher) enqueue(job delivery) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		d.deadLetter(job, 0, context.Canceled)
+		return
+	}
+	d.wg.Add(1)
+	select {
+	case d.queue <- job:
+	default:
+		d.wg.Done()
+		d.deadLetter(job, 0, errQueueFull)
+	}
+}
+
+// work delivers the queued notifications until the dispatcher is closed, then records the
+// ones left in the queue as dead letters.
+func (d *Dispatcher) work() {
+	for {
+		select {
+		case job := <-d.queue:
+			d.deliver(d.ctx, job)
+			d.wg.Done()
+		case <-d.ctx.Done():
+			for {
+				select {
+				case job := <-d.queue:
+					d.deliver(d.ctx, job)
+					d.wg.Done()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Wait blocks until the queued and in-flight deliveries are done.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// Close stops the workers, recording the undelivered notifications as dead letters, and
+// waits for them.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+	d.cancel()
+	d.wg.Wait()
+}
+
+// deliver posts the payload, retrying with exponential backoff, and records a dead letter
+// once every attempt failed.
+func (d *Dispatcher) deliver(ctx context.Context, job delivery) {
+	logger := zap.S().Named("webhook").With("subscription", job.subscription.ID, "event", job.event.ID, "type", job.event.Type)
+
+	// Left in the queue when the dispatcher was closed
+	if err := ctx.Err(); err != nil {
+		d.deadLetter(job, 0, err)
+		return
+	}
+	maxAttempts := max(d.cfg.MaxAttempts, 1)
+	attempts := 0
+	var err error
+retry:
+	for attempts < maxAttempts {
+		attempts++
+		var retryable bool
+		retryable, err = d.post(ctx, job.subscription, job.payload)
+		if err == nil {
+			logger.Debugw("Notification delivered", "attempt", attempts)
+			return
+		}
+		logger.Debugw("Notification delivery failed", "attempt", attempts, "error", err)
+		if !retryable || attempts == maxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break retry
+		case <-time.After(d.cfg.RetryBackoff << (attempts - 1)):
+		}
+	}
+
+	d.deadLetter(job, attempts, err)
+}
+
+// deadLetter records the notification of job as undelivered after attempts failed with err.
+func (d *Dispatcher) deadLetter(job delivery, attempts int, err error) {
+	logger := zap.S().Named("webhook").With("subscription", job.subscription.ID, "event", job.event.ID, "type", job.event.Type)
+	logger.Warnw("Giving up on notification", "attempts", attempts, "error", err)
+	// Recorded even when the dispatcher is closed, so the notification is not lost
+	_, dlErr := d.store.Subscription().CreateDeadLetter(context.Background(), model.DeadLetter{
+		SubscriptionID: job.subscription.ID,
+		EventID:        job.event.ID,
+		EventType:      job.event.Type,
+		Payload:        string(job.payload),
+		Attempts:       attempts,
+		LastError:      err.Error(),
+	})
+	if dlErr != nil {
+		logger.Errorw("Failed to record dead letter", "error", dlErr)
+	}
+}
+
+// post sends a single delivery attempt and reports whether a failure is worth retrying.
+func (d *Dispatcher) post(ctx context.Context, subscription model.Subscription, payload []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(subscription.Secret, timestamp, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return !errors.Is(err, ErrForbiddenAddress) && ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("subscriber responded with status %d", resp.StatusCode)
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError,
+		resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests:
+		return true, err
+	}
+	return false, err
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of timestamp, a dot and payload, keyed with secret.
+// Signing the timestamp lets subscribers reject the notifications replayed later on.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckURL rejects the subscriber URLs whose host is, or resolves to, a loopback or
+// link-local address.
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return checkAddr(addr)
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if err := checkAddr(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAddress is the dialer control rejecting the connections to forbidden addresses.
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	return checkAddr(addrPort.Addr())
+}
+
+func checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsUnspecified() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
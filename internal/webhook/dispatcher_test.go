@@ -0,0 +1,279 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/dcm-placement-api/internal/api/server"
+	"github.com/dcm-project/dcm-placement-api/internal/config"
+	"github.com/dcm-project/dcm-placement-api/internal/store"
+	"github.com/dcm-project/dcm-placement-api/internal/store/model"
+	"github.com/google/uuid"
+)
+
+func newTestStore(t *testing.T) store.Store {
+	t.Helper()
+
+	defaults, err := config.New()
+	if err != nil {
+		t.Fatalf("reading configuration: %v", err)
+	}
+	dbCfg := *defaults.Database
+	dbCfg.Type = "sqlite"
+	dbCfg.Name = fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+
+	db, err := store.InitDB(&config.Config{Database: &dbCfg})
+	if err != nil {
+		t.Fatalf("initializing store: %v", err)
+	}
+	s := store.NewStore(db)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func subscribe(t *testing.T, s store.Store, url string, eventTypes ...string) model.Subscription {
+	t.Helper()
+	sub, err := s.Subscription().Create(context.Background(), model.Subscription{
+		ID:         uuid.New(),
+		URL:        url,
+		EventTypes: eventTypes,
+		Secret:     "s3cr3t",
+	})
+	if err != nil {
+		t.Fatalf("creating subscription: %v", err)
+	}
+	return *sub
+}
+
+// newTestDispatcher returns a dispatcher allowed to reach the loopback test servers.
+func newTestDispatcher(s store.Store, cfg Config) *Dispatcher {
+	d := NewDispatcher(s, cfg)
+	d.client = &http.Client{Timeout: cfg.Timeout}
+	return d
+}
+
+func TestDeliverSignedCloudEvent(t *testing.T) {
+	s := newTestStore(t)
+
+	var calls atomic.Int32
+	received := make(chan CloudEvent, 1)
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to exercise retries
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get(TimestampHeader)
+		if sent, err := strconv.ParseInt(timestamp, 10, 64); err != nil || time.Since(time.Unix(sent, 0)) > time.Minute {
+			t.Errorf("unexpected timestamp %q", timestamp)
+		}
+		if got := r.Header.Get(SignatureHeader); got != "sha256="+Sign("s3cr3t", timestamp, body) {
+			t.Errorf("unexpected signature %q", got)
+		}
+		if Sign("s3cr3t", timestamp, body) == Sign("s3cr3t", "0", body) {
+			t.Error("signature does not cover the timestamp")
+		}
+		if got := r.Header.Get("Content-Type"); got != contentType {
+			t.Errorf("unexpected content type %q", got)
+		}
+		var event CloudEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("decoding event: %v", err)
+		}
+		received <- event
+	}))
+	defer hook.Close()
+
+	subscribe(t, s, hook.URL, "application.created")
+	// Not interested in the event, must not be called
+	subscribe(t, s, hook.URL+"/other", "application.deleted")
+
+	d := newTestDispatcher(s, Config{Timeout: time.Second, MaxAttempts: 3, RetryBackoff: time.Millisecond, Source: "/test"})
+	path := "applications/123"
+	d.Notify(context.Background(), "application.created", server.ApplicationResponse{Path: &path})
+	d.Wait()
+
+	select {
+	case event := <-received:
+		if event.Type != TypePrefix+"application.created" || event.SpecVersion != "1.0" || event.Source != "/test" || event.Subject != path {
+			t.Fatalf("unexpected event %+v", event)
+		}
+	default:
+		t.Fatal("notification not delivered")
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 attempts, got %d", calls.Load())
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	s := newTestStore(t)
+
+	var calls atomic.Int32
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer hook.Close()
+	sub := subscribe(t, s, hook.URL)
+
+	d := newTestDispatcher(s, Config{Timeout: time.Second, MaxAttempts: 3, RetryBackoff: time.Millisecond})
+	d.Notify(context.Background(), "application.deleted", server.ApplicationResponse{})
+	d.Wait()
+
+	if calls.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls.Load())
+	}
+	deadLetters, err := s.Subscription().ListDeadLetters(context.Background(), sub.ID)
+	if err != nil {
+		t.Fatalf("ListDeadLetters: %v", err)
+	}
+	if len(deadLetters) != 1 {
+		t.Fatalf("expected 1 dead letter, got %d", len(deadLetters))
+	}
+	if dl := deadLetters[0]; dl.Attempts != 3 || dl.EventType != TypePrefix+"application.deleted" || dl.LastError == "" || dl.Payload == "" {
+		t.Fatalf("unexpected dead letter %+v", dl)
+	}
+}
+
+func TestCloseStopsRetries(t *testing.T) {
+	s := newTestStore(t)
+
+	var calls atomic.Int32
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer hook.Close()
+	sub := subscribe(t, s, hook.URL)
+
+	d := newTestDispatcher(s, Config{Timeout: time.Second, MaxAttempts: 5, RetryBackoff: time.Hour})
+	d.Notify(context.Background(), "application.deleted", server.ApplicationResponse{})
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		d.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close waited for the retry backoff")
+	}
+
+	deadLetters, err := s.Subscription().ListDeadLetters(context.Background(), sub.ID)
+	if err != nil {
+		t.Fatalf("ListDeadLetters: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Attempts != 1 {
+		t.Fatalf("expected 1 dead letter after 1 attempt, got %+v", deadLetters)
+	}
+}
+
+func TestQueueFull(t *testing.T) {
+	s := newTestStore(t)
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+	}))
+	defer hook.Close()
+	var subs []model.Subscription
+	for range 3 {
+		subs = append(subs, subscribe(t, s, hook.URL))
+	}
+
+	// A single worker takes at most one delivery while a second one waits in the queue
+	d := newTestDispatcher(s, Config{Timeout: 5 * time.Second, MaxAttempts: 1, Workers: 1, QueueSize: 1})
+	d.Notify(context.Background(), "application.created", server.ApplicationResponse{})
+	close(release)
+	d.Wait()
+
+	full := 0
+	for _, sub := range subs {
+		deadLetters, err := s.Subscription().ListDeadLetters(context.Background(), sub.ID)
+		if err != nil {
+			t.Fatalf("ListDeadLetters: %v", err)
+		}
+		for _, dl := range deadLetters {
+			if dl.LastError == errQueueFull.Error() && dl.Attempts == 0 {
+				full++
+			}
+		}
+	}
+	if full == 0 || int(calls.Load())+full != 3 {
+		t.Fatalf("expected the deliveries beyond the queue to be dead letters, got %d deliveries and %d dead letters", calls.Load(), full)
+	}
+}
+
+func TestDeliverRejectsLoopback(t *testing.T) {
+	s := newTestStore(t)
+
+	var calls atomic.Int32
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer hook.Close()
+	// Created as if the name of the subscriber resolved to a public address at the time
+	sub := subscribe(t, s, hook.URL)
+
+	d := NewDispatcher(s, Config{Timeout: time.Second, MaxAttempts: 3, RetryBackoff: time.Millisecond})
+	if d.client.Transport.(*http.Transport).Proxy != nil {
+		t.Fatal("expected the deliveries not to go through a proxy")
+	}
+	d.Notify(context.Background(), "application.created", server.ApplicationResponse{})
+	d.Wait()
+
+	if calls.Load() != 0 {
+		t.Fatalf("expected no delivery to a loopback address, got %d", calls.Load())
+	}
+	deadLetters, err := s.Subscription().ListDeadLetters(context.Background(), sub.ID)
+	if err != nil {
+		t.Fatalf("ListDeadLetters: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Attempts != 1 || !strings.Contains(deadLetters[0].LastError, ErrForbiddenAddress.Error()) {
+		t.Fatalf("expected 1 dead letter after a single attempt, got %+v", deadLetters)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	for _, tc := range []struct {
+		url       string
+		forbidden bool
+	}{
+		{url: "https://hooks.example.com/dcm"},
+		{url: "http://203.0.113.10:8080/dcm"},
+		{url: "http://[2001:db8::1]/dcm"},
+		{url: "http://127.0.0.1:8080/dcm", forbidden: true},
+		{url: "http://127.1.2.3/dcm", forbidden: true},
+		{url: "http://localhost/dcm", forbidden: true},
+		{url: "http://[::1]/dcm", forbidden: true},
+		{url: "http://0.0.0.0/dcm", forbidden: true},
+		{url: "http://169.254.169.254/latest/meta-data", forbidden: true},
+		{url: "http://[fe80::1]/dcm", forbidden: true},
+		{url: "http://[::ffff:127.0.0.1]/dcm", forbidden: true},
+	} {
+		t.Run(tc.url, func(t *testing.T) {
+			err := CheckURL(context.Background(), tc.url)
+			if forbidden := errors.Is(err, ErrForbiddenAddress); forbidden != tc.forbidden {
+				t.Fatalf("expected forbidden=%v, got %v", tc.forbidden, err)
+			}
+		})
+	}
+}
@@ -4,54 +4,32 @@ import rego.v1
 
 default valid := false
 
-# Helper rule to get the server URL from data with fallback
-server_url := data.server_url if {
-    data.server_url
-}
-
-# Default server URL if not provided in data
-default server_url := "http://localhost:8080"
-
-# Helper function to fetch zones for a given set of labels
-fetch_zones(labels) := zone_names if {
-    response := http.send({
-        "method": "POST",
-        "url": sprintf("%s/namespaces", [server_url]),
-        "headers": {
-            "Content-Type": "application/json"
-        },
-        "body": {
-            "labels": labels
-        }
-    })
+# Zones whose provider namespace carries every label of labels. The placement service
+# lists the namespaces of the providers in input.available_zones
+labelled_zones(labels) := [zone.name |
+    some zone in input.available_zones
+    every key, value in labels {
+        zone.labels[key] == value
+    }
+]
 
-    # Check if the API call was successful
-    response.status_code == 200
+# Applications failed over by the placement service ask for the backup environment
+failover if input.environment == "backup"
 
-    # Extract zone names from the response
-    zone_names := [ns.name | ns := response.body.namespaces[_]]
+# Required zones for tier 2 - production zones first
+required_zones := labelled_zones(data.t2.production_labels) if {
+    not failover
+    count(labelled_zones(data.t2.production_labels)) > 0
 }
 
-# Default return empty list if fetch fails
-default fetch_zones(_) := []
-
-# Required zones for tier 2 - try production labels first
-required_zones := zone_names if {
-    production_labels := data.t2.production_labels
-    production_zones := fetch_zones(production_labels)
-    count(production_zones) > 0
-    zone_names := production_zones
+# Fallback to the backup zones when no zone is labelled for production
+required_zones := labelled_zones(data.t2.backup_labels) if {
+    not failover
+    count(labelled_zones(data.t2.production_labels)) == 0
 }
 
-# Fallback to backup labels if production returns empty
-required_zones := zone_names if {
-    production_labels := data.t2.production_labels
-    production_zones := fetch_zones(production_labels)
-    count(production_zones) == 0
-
-    backup_labels := data.t2.backup_labels
-    zone_names := fetch_zones(backup_labels)
-}
+# Backup zones once the application failed over
+required_zones := labelled_zones(data.t2.backup_labels) if failover
 
 # Generate failures if zones are defined but not equal to required_zones
 failures contains failure if {
@@ -68,13 +46,147 @@ failures contains failure if {
     failure := sprintf("Unexpected zone '%s' in input specification", [zone])
 }
 
-# Input is valid if zones are not defined OR zones exactly match required_zones
+# Zones whose available capacity, as reported in input.capacity, cannot host the application
+saturated_zones contains zone if {
+    some zone in required_zones
+    available := input.capacity[zone].available
+    not fits(available, input.requirements)
+}
+
+fits(available, requirements) if {
+    available.cpu >= requirements.cpu
+    available.ram >= requirements.ram
+}
+
+failures contains failure if {
+    some zone in saturated_zones
+    zone in checked_zones
+    available := input.capacity[zone].available
+    failure := sprintf("Zone '%s' lacks capacity: %d CPU and %d GiB RAM available, %d CPU and %d GiB RAM required", [zone, available.cpu, available.ram, input.requirements.cpu, input.requirements.ram])
+}
+
+# Set when the zones are left to the policy and too few required zones can host the application
+unplaceable if {
+    not input.zones
+    count(candidate_zones) == 0
+}
+
+unplaceable if {
+    not input.zones
+    count(candidate_zones) < replica_zones
+}
+
+failures contains "No required zone can host the application" if {
+    unplaceable
+    count(candidate_zones) == 0
+}
+
+failures contains failure if {
+    unplaceable
+    count(candidate_zones) > 0
+    failure := sprintf("Only %d zones can host the application, %d required", [count(candidate_zones), replica_zones])
+}
+
+# Zones whose capacity and affinity violations are reported: the requested ones, or every
+# required zone when too few of them can host the application. Otherwise the application is
+# placed in the candidate zones, leaving the others out
+checked_zones := input.zones if {
+    input.zones
+}
+
+checked_zones := required_zones if {
+    unplaceable
+}
+
+# Violations of the affinity rules, input.affinity and input.anti_affinity hold the
+# applications referenced by the rules and the zones they are placed in
+affinity_failures contains "Affinity matches no application" if {
+    input.affinity
+    count(input.affinity.applications) == 0
+}
+
+affinity_failures contains failure if {
+    count(input.affinity.applications) > 0
+    some zone in checked_zones
+    not zone in input.affinity.zones
+    failure := sprintf("Zone '%s' hosts none of the applications required by affinity", [zone])
+}
+
+affinity_failures contains failure if {
+    some zone in checked_zones
+    zone in input.anti_affinity.zones
+    failure := sprintf("Zone '%s' hosts an application excluded by anti-affinity", [zone])
+}
+
+failures contains failure if {
+    some failure in affinity_failures
+}
+
+# Environment of the required zones, backup once failed over or when no zone is labelled
+# for production
+default required_environment := "production"
+
+required_environment := "backup" if failover
+
+required_environment := "backup" if {
+    count(labelled_zones(data.t2.production_labels)) == 0
+}
+
+# Share of the capacity of zone left, 1 for zones without a configured capacity
+zone_score(zone) := min([
+    input.capacity[zone].available.cpu / input.capacity[zone].capacity.cpu,
+    input.capacity[zone].available.ram / input.capacity[zone].capacity.ram,
+]) if {
+    input.capacity[zone].capacity.cpu > 0
+    input.capacity[zone].capacity.ram > 0
+} else := 1
+
+# Region of zone, from the region label of its namespace when set
+zone_region(zone) := {"region": region} if {
+    regions := {z.labels.region | some z in input.available_zones; z.name == zone}
+    count(regions) == 1
+    some region in regions
+} else := {}
+
+# Zones breaking the affinity rules on their own
+breaks_affinity(zone) if {
+    count(input.affinity.applications) > 0
+    not zone in input.affinity.zones
+}
+
+breaks_affinity(zone) if zone in input.anti_affinity.zones
+
+# Required zones with room for the application that keep to the affinity rules, scored by the
+# share of their capacity left
+candidate_zones := [candidate |
+    some zone in required_zones
+    not zone in saturated_zones
+    not breaks_affinity(zone)
+    reason := sprintf("%s zone, %d%% of its capacity left", [required_environment, round(zone_score(zone) * 100)])
+    candidate := object.union({"zone": zone, "score": zone_score(zone), "reason": reason}, zone_region(zone))
+]
+
+# Number of zones the application is deployed in, data.t2.replica_zones when set, every
+# candidate zone otherwise
+replica_zones := data.t2.replica_zones
+
+replica_zones := count(candidate_zones) if {
+    not data.t2.replica_zones
+}
+
+# Input is valid if zones are not defined and enough required zones can host the application,
+# OR zones exactly match required_zones and every one of them has room for the application,
+# the affinity rules holding in both cases
 valid if {
-    not input.zones  # Zones field does not exist - this is valid
+    not input.zones  # Zones field does not exist - the policy picks the candidate zones
+    not unplaceable
+    count(affinity_failures) == 0
 }
 
 valid if {
     input.zones  # Zones field exists
+    count(saturated_zones) == 0
+    count(affinity_failures) == 0
     # All required zones are present
     every zone in required_zones {
         zone in input.zones
@@ -0,0 +1,156 @@
+package tier2_test
+
+import rego.v1
+
+import data.tier2
+
+# Zones of the providers, as listed by the placement service in input.available_zones
+production_and_backup := [
+	{"name": "zone-a", "provider": "default", "labels": {"tier": "2", "environment": "production"}},
+	{"name": "zone-b", "provider": "default", "labels": {"tier": "2", "environment": "production"}},
+	{"name": "zone-c", "provider": "default", "labels": {"tier": "2", "environment": "backup"}},
+	{"name": "zone-d", "provider": "default", "labels": {"tier": "1", "environment": "production"}},
+]
+
+backup_only := [
+	{"name": "zone-c", "provider": "default", "labels": {"tier": "2", "environment": "backup"}},
+	{"name": "zone-d", "provider": "default", "labels": {"tier": "1", "environment": "production"}},
+]
+
+# app is an application named web, placed among production_and_backup
+app(fields) := object.union({"name": "web", "available_zones": production_and_backup}, fields)
+
+test_production_zones_required if {
+	tier2.required_zones == ["zone-a", "zone-b"] with input as app({})
+}
+
+test_backup_zones_when_no_production_zone if {
+	tier2.required_zones == ["zone-c"] with input as app({"available_zones": backup_only})
+}
+
+test_backup_zones_after_failover if {
+	tier2.required_zones == ["zone-c"] with input as app({"environment": "backup"})
+	tier2.valid with input as app({"environment": "backup", "zones": ["zone-c"]})
+}
+
+test_production_zones_after_failback if {
+	tier2.required_zones == ["zone-a", "zone-b"] with input as app({"environment": "production"})
+}
+
+test_no_zone_without_available_zones if {
+	tier2.required_zones == [] with input as {"name": "web"}
+	tier2.failures == {"No required zone can host the application"} with input as {"name": "web"}
+	not tier2.valid with input as {"name": "web"}
+}
+
+test_valid_without_zones if {
+	tier2.valid with input as app({})
+	count(tier2.failures) == 0 with input as app({})
+}
+
+test_valid_with_required_zones if {
+	tier2.valid with input as app({"zones": ["zone-b", "zone-a"]})
+}
+
+test_missing_zone if {
+	failures := tier2.failures with input as app({"zones": ["zone-a"]})
+	failures == {"Missing required zone 'zone-b' in input specification"}
+	not tier2.valid with input as app({"zones": ["zone-a"]})
+}
+
+test_unexpected_zone if {
+	zones := ["zone-a", "zone-b", "zone-c"]
+	failures := tier2.failures with input as app({"zones": zones})
+	failures == {"Unexpected zone 'zone-c' in input specification"}
+	not tier2.valid with input as app({"zones": zones})
+}
+
+saturated_zone_a := {
+	"requirements": {"cpu": 2, "ram": 4},
+	"capacity": {"zone-a": {"available": {"cpu": 1, "ram": 8}}, "zone-b": {"available": {"cpu": 4, "ram": 8}}},
+}
+
+test_saturated_zone_left_out if {
+	saturated := app(saturated_zone_a)
+	tier2.valid with input as saturated
+	count(tier2.failures) == 0 with input as saturated
+	[candidate.zone | some candidate in tier2.candidate_zones] == ["zone-b"] with input as saturated
+	tier2.replica_zones == 1 with input as saturated
+}
+
+test_saturated_zone_requested if {
+	saturated := app(object.union(saturated_zone_a, {"zones": ["zone-a", "zone-b"]}))
+	failures := tier2.failures with input as saturated
+	failures == {"Zone 'zone-a' lacks capacity: 1 CPU and 8 GiB RAM available, 2 CPU and 4 GiB RAM required"}
+	not tier2.valid with input as saturated
+}
+
+test_saturated_zone_short_of_replica_zones if {
+	saturated := app(saturated_zone_a)
+	failures := tier2.failures with input as saturated with data.t2.replica_zones as 2
+	failures == {
+		"Only 1 zones can host the application, 2 required",
+		"Zone 'zone-a' lacks capacity: 1 CPU and 8 GiB RAM available, 2 CPU and 4 GiB RAM required",
+	}
+	not tier2.valid with input as saturated with data.t2.replica_zones as 2
+}
+
+test_anti_affinity_left_out if {
+	excluded := app({"anti_affinity": {"applications": ["db"], "zones": ["zone-b"]}})
+	tier2.valid with input as excluded
+	[candidate.zone | some candidate in tier2.candidate_zones] == ["zone-a"] with input as excluded
+}
+
+test_anti_affinity_requested if {
+	excluded := app({"zones": ["zone-a", "zone-b"], "anti_affinity": {"applications": ["db"], "zones": ["zone-b"]}})
+	failures := tier2.failures with input as excluded
+	failures == {"Zone 'zone-b' hosts an application excluded by anti-affinity"}
+	not tier2.valid with input as excluded
+}
+
+test_affinity_without_application if {
+	alone := app({"affinity": {"applications": [], "zones": []}})
+	tier2.failures == {"Affinity matches no application"} with input as alone
+	not tier2.valid with input as alone
+}
+
+test_candidate_zones if {
+	scored := app({
+		"available_zones": [
+			{"name": "zone-a", "provider": "default", "labels": {"tier": "2", "environment": "production"}},
+			{"name": "zone-b", "provider": "default", "labels": {"tier": "2", "environment": "production", "region": "us-east"}},
+		],
+		"requirements": {"cpu": 1, "ram": 1},
+		"capacity": {"zone-a": {"capacity": {"cpu": 8, "ram": 16}, "available": {"cpu": 2, "ram": 8}}},
+	})
+	candidates := tier2.candidate_zones with input as scored
+	count(candidates) == 2
+	candidates[0] == {"zone": "zone-a", "score": 0.25, "reason": "production zone, 25% of its capacity left"}
+	candidates[1] == {"zone": "zone-b", "region": "us-east", "score": 1, "reason": "production zone, 100% of its capacity left"}
+}
+
+test_candidate_zones_after_failover if {
+	candidates := tier2.candidate_zones with input as app({"environment": "backup"})
+	candidates == [{"zone": "zone-c", "score": 1, "reason": "backup zone, 100% of its capacity left"}]
+}
+
+test_candidate_zones_exclude_saturated_and_excluded_zones if {
+	excluded := app({
+		"requirements": {"cpu": 2, "ram": 4},
+		"capacity": {"zone-a": {"available": {"cpu": 1, "ram": 8}}},
+		"anti_affinity": {"applications": ["db"], "zones": ["zone-b"]},
+	})
+	tier2.candidate_zones == [] with input as excluded
+	failures := tier2.failures with input as excluded
+	failures == {
+		"No required zone can host the application",
+		"Zone 'zone-a' lacks capacity: 1 CPU and 8 GiB RAM available, 2 CPU and 4 GiB RAM required",
+		"Zone 'zone-b' hosts an application excluded by anti-affinity",
+	}
+	not tier2.valid with input as excluded
+}
+
+test_replica_zones if {
+	tier2.replica_zones == 2 with input as app({})
+	tier2.replica_zones == 1 with input as app({}) with data.t2.replica_zones as 1
+}